@@ -6,8 +6,14 @@ import (
 	"blog/internal/database/migrations"
 	"blog/internal/database/postgre"
 	"blog/internal/logger"
+	"blog/internal/mailer"
+	"blog/internal/moderation"
+	"blog/internal/repository"
+	"blog/internal/service"
 	"blog/internal/storage/minio"
 	"blog/internal/transport/rest/servers"
+	"blog/internal/validation"
+	"blog/internal/workers"
 	"context"
 	"log"
 )
@@ -43,7 +49,33 @@ func main() {
 		log.Fatal(err)
 	}
 
-	server, err := servers.NewBlogServer(cfg.BlogServerConfig, minioClient, db, zapLogger)
+	repo := repository.NewBlogRepository(db.DB)
+	postsService := service.NewPostsService(repo, minioClient, minioClient.Bucket, cfg.BlogServerConfig.Language)
+
+	sessionsService := service.NewSessionsService(repo)
+
+	trashPurger := workers.NewTrashPurger(cfg.TrashPurgerConfig, postsService, sessionsService, zapLogger)
+	go trashPurger.Run(ctx)
+
+	postScheduler := workers.NewPostScheduler(cfg.PostSchedulerConfig, postsService, zapLogger)
+	go postScheduler.Run(ctx)
+
+	spamChecker := moderation.NewHeuristicChecker(cfg.HeuristicCheckerConfig, repo)
+
+	sender, err := mailer.NewSender(cfg.MailerConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	queueSender := mailer.NewQueueSender(cfg.MailerConfig, sender, zapLogger)
+	go queueSender.Run(ctx)
+
+	emailValidator, err := validation.NewEmailValidator(cfg.EmailValidatorConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	server, err := servers.NewBlogServer(cfg.BlogServerConfig, minioClient, db, spamChecker, queueSender, emailValidator, zapLogger)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -2,8 +2,12 @@ package config
 
 import (
 	"blog/internal/database/postgre"
+	"blog/internal/mailer"
+	"blog/internal/moderation"
 	"blog/internal/storage/minio"
 	"blog/internal/transport/rest/servers"
+	"blog/internal/validation"
+	"blog/internal/workers"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
@@ -14,6 +18,16 @@ type Config struct {
 	servers.BlogServerConfig
 
 	minio.MinioClientConfig
+
+	workers.TrashPurgerConfig
+
+	workers.PostSchedulerConfig
+
+	moderation.HeuristicCheckerConfig
+
+	mailer.MailerConfig
+
+	validation.EmailValidatorConfig
 }
 
 func NewConfig() (*Config, error) {
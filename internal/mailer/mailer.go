@@ -0,0 +1,124 @@
+package mailer
+
+import (
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/smtp"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Sender interface {
+	Send(msg *Message) error
+}
+
+type MailerConfig struct {
+	Driver       string `env:"MAIL_DRIVER" env-default:"log"`
+	From         string `env:"MAIL_FROM" env-default:"no-reply@localhost"`
+	LogFile      string `env:"MAIL_LOG_FILE"`
+	SMTPHost     string `env:"SMTP_HOST" env-default:"localhost"`
+	SMTPPort     string `env:"SMTP_PORT" env-default:"587"`
+	SMTPUsername string `env:"SMTP_USERNAME"`
+	SMTPPassword string `env:"SMTP_PASSWORD"`
+	QueueSize    int    `env:"MAIL_QUEUE_SIZE" env-default:"100"`
+}
+
+func NewSender(cfg MailerConfig) (Sender, error) {
+	switch cfg.Driver {
+	case consts.SMTPMailDriver:
+		return NewSMTPSender(cfg), nil
+	case consts.LogMailDriver:
+		if cfg.LogFile == "" {
+			return NewLogSender(cfg.From, os.Stdout), nil
+		}
+		file, err := os.OpenFile(cfg.LogFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, err
+		}
+		return NewLogSender(cfg.From, file), nil
+	default:
+		return nil, errors.ErrUnknownMailDriver
+	}
+}
+
+type SMTPSender struct {
+	cfg MailerConfig
+}
+
+func NewSMTPSender(cfg MailerConfig) *SMTPSender {
+	return &SMTPSender{
+		cfg: cfg,
+	}
+}
+
+func (s *SMTPSender) Send(msg *Message) error {
+	data, err := formatMessage(s.cfg.From, msg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if s.cfg.SMTPUsername != "" {
+		auth = smtp.PlainAuth("", s.cfg.SMTPUsername, s.cfg.SMTPPassword, s.cfg.SMTPHost)
+	}
+
+	return smtp.SendMail(net.JoinHostPort(s.cfg.SMTPHost, s.cfg.SMTPPort), auth, s.cfg.From, []string{msg.To}, data)
+}
+
+// LogSender writes messages to w instead of delivering them, which is enough
+// to follow links from the messages during local development.
+type LogSender struct {
+	from string
+	mu   sync.Mutex
+	w    io.Writer
+}
+
+func NewLogSender(from string, w io.Writer) *LogSender {
+	return &LogSender{
+		from: from,
+		w:    w,
+	}
+}
+
+func (s *LogSender) Send(msg *Message) error {
+	data, err := formatMessage(s.from, msg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err = fmt.Fprintf(s.w, "%s\r\n\r\n", data)
+	return err
+}
+
+func formatMessage(from string, msg *Message, date time.Time) ([]byte, error) {
+	if strings.ContainsAny(from+msg.To+msg.Subject, "\r\n") {
+		return nil, errors.ErrInvalidMailHeader
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+
+	return []byte(b.String()), nil
+}
@@ -0,0 +1,110 @@
+package mailer
+
+import (
+	"blog/internal/logger"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogSender_Send(t *testing.T) {
+	tests := []struct {
+		name          string
+		msg           *Message
+		expectedErr   error
+		expectedParts []string
+	}{
+		{
+			name: "successful",
+			msg: &Message{
+				To:      "test@yandex.ru",
+				Subject: "Password reset",
+				Body:    "first line\nhttp://localhost:8080/reset-password?token=token",
+			},
+			expectedParts: []string{
+				"From: no-reply@localhost\r\n",
+				"To: test@yandex.ru\r\n",
+				"Subject: Password reset\r\n",
+				"Content-Type: text/plain; charset=utf-8\r\n",
+				"\r\n\r\nfirst line\r\nhttp://localhost:8080/reset-password?token=token",
+			},
+		},
+		{
+			name: "non-ascii subject",
+			msg: &Message{
+				To:      "test@yandex.ru",
+				Subject: "Сброс пароля",
+				Body:    "body",
+			},
+			expectedParts: []string{
+				"Subject: =?utf-8?q?",
+			},
+		},
+		{
+			name: "header injection",
+			msg: &Message{
+				To:      "test@yandex.ru\r\nBcc: victim@yandex.ru",
+				Subject: "Password reset",
+				Body:    "body",
+			},
+			expectedErr: errors.ErrInvalidMailHeader,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			sender := NewLogSender("no-reply@localhost", &buf)
+
+			err := sender.Send(test.msg)
+			assert.Equal(t, test.expectedErr, err)
+
+			for _, part := range test.expectedParts {
+				assert.Contains(t, buf.String(), part)
+			}
+			if test.expectedErr != nil {
+				assert.Empty(t, buf.String())
+			}
+		})
+	}
+}
+
+func TestNewSender(t *testing.T) {
+	sender, err := NewSender(MailerConfig{Driver: consts.SMTPMailDriver})
+	assert.NoError(t, err)
+	assert.IsType(t, &SMTPSender{}, sender)
+
+	sender, err = NewSender(MailerConfig{Driver: consts.LogMailDriver})
+	assert.NoError(t, err)
+	assert.IsType(t, &LogSender{}, sender)
+
+	_, err = NewSender(MailerConfig{Driver: "carrier-pigeon"})
+	assert.Equal(t, errors.ErrUnknownMailDriver, err)
+}
+
+func TestQueueSender(t *testing.T) {
+	var buf bytes.Buffer
+	sender := NewQueueSender(MailerConfig{QueueSize: 1}, NewLogSender("no-reply@localhost", &buf), logger.NewLogger())
+
+	msg := &Message{To: "test@yandex.ru", Subject: "Password reset", Body: "body"}
+	assert.NoError(t, sender.Send(msg))
+	assert.Equal(t, errors.ErrMailQueueFull, sender.Send(msg))
+	assert.Empty(t, buf.String())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		sender.Run(ctx)
+		close(done)
+	}()
+
+	assert.Eventually(t, func() bool { return len(sender.queue) == 0 }, time.Second, time.Millisecond)
+	cancel()
+	<-done
+	assert.Contains(t, buf.String(), "To: test@yandex.ru\r\n")
+}
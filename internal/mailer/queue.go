@@ -0,0 +1,49 @@
+package mailer
+
+import (
+	"blog/internal/logger"
+	"blog/pkg/consts/errors"
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// QueueSender hands messages over to a background delivery loop, so that
+// callers neither wait for the mail server nor reveal by their response time
+// whether a message was sent. Delivery failures are logged by the loop.
+type QueueSender struct {
+	sender Sender
+	queue  chan *Message
+	logger logger.Logger
+}
+
+func NewQueueSender(cfg MailerConfig, sender Sender, zapLogger logger.Logger) *QueueSender {
+	return &QueueSender{
+		sender: sender,
+		queue:  make(chan *Message, cfg.QueueSize),
+		logger: zapLogger.WithFields(zap.String("worker", "QueueSender")),
+	}
+}
+
+func (s *QueueSender) Send(msg *Message) error {
+	select {
+	case s.queue <- msg:
+		return nil
+	default:
+		s.logger.Error("Failed to queue message", zap.Error(errors.ErrMailQueueFull))
+		return errors.ErrMailQueueFull
+	}
+}
+
+func (s *QueueSender) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-s.queue:
+			if err := s.sender.Send(msg); err != nil {
+				s.logger.Error("Failed to send message", zap.Error(err))
+			}
+		}
+	}
+}
@@ -4,17 +4,25 @@ type RegistrateUserRequest struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
 	Role     string `json:"role"`
+	Device   string `json:"device"`
+
+	UserAgent string `json:"-"`
+	IP        string `json:"-"`
 }
 
 type RegistrateUserResponse struct {
 	Message      string `json:"message"`
 	AccessToken  string `json:"-"`
-	RefreshToken string `json:"-"`
+	RefreshToken string `json:"refresh_token"`
 }
 
 type LoginUserRequest struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
+	Device   string `json:"device"`
+
+	UserAgent string `json:"-"`
+	IP        string `json:"-"`
 }
 
 type LoginUserResponse struct {
@@ -30,5 +38,46 @@ type RefreshUserTokenRequest struct {
 type RefreshUserTokenResponse struct {
 	Message      string `json:"message"`
 	AccessToken  string `json:"-"`
-	RefreshToken string `json:"-"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+type LogoutUserRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type LogoutUserResponse struct {
+	Message string `json:"message"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+type ForgotPasswordResponse struct {
+	Message string `json:"message"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+type ResetPasswordResponse struct {
+	Message string `json:"message"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+type VerifyEmailResponse struct {
+	Message string `json:"message"`
+}
+
+type ResendVerificationRequest struct {
+	UserId string `json:"-"`
+}
+
+type ResendVerificationResponse struct {
+	Message string `json:"message"`
 }
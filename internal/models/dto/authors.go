@@ -0,0 +1,16 @@
+package dto
+
+import "blog/internal/models/entities"
+
+type GetAuthorRequest struct {
+	AuthorId string `json:"-"`
+}
+
+type GetAuthorResponse struct {
+	Author entities.Author `json:"author"`
+}
+
+type GetAuthorPostsRequest struct {
+	AuthorId string `json:"-"`
+	PostsQuery
+}
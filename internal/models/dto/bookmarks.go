@@ -0,0 +1,99 @@
+package dto
+
+import "blog/internal/models/entities"
+
+type AddBookmarkRequest struct {
+	UserId string `json:"-"`
+	PostId string `json:"-"`
+}
+
+type AddBookmarkResponse struct {
+	Message string `json:"message"`
+}
+
+type DeleteBookmarkRequest struct {
+	UserId string `json:"-"`
+	PostId string `json:"-"`
+}
+
+type DeleteBookmarkResponse struct {
+	Message string `json:"message"`
+}
+
+type GetBookmarksRequest struct {
+	UserId string `json:"-"`
+	Limit  int    `json:"-"`
+	Cursor string `json:"-"`
+}
+
+type GetBookmarksResponse struct {
+	Posts      []entities.BookmarkedPost `json:"posts"`
+	NextCursor string                    `json:"next_cursor,omitempty"`
+}
+
+type CreateReadingListRequest struct {
+	UserId string `json:"-"`
+	Name   string `json:"name"`
+}
+
+type CreateReadingListResponse struct {
+	Message     string               `json:"message"`
+	ReadingList entities.ReadingList `json:"reading_list"`
+}
+
+type GetReadingListsRequest struct {
+	UserId string `json:"-"`
+}
+
+type GetReadingListsResponse struct {
+	ReadingLists []entities.ReadingList `json:"reading_lists"`
+}
+
+type GetReadingListRequest struct {
+	UserId string `json:"-"`
+	ListId string `json:"-"`
+}
+
+type GetReadingListResponse struct {
+	ReadingList entities.ReadingList `json:"reading_list"`
+}
+
+type RenameReadingListRequest struct {
+	UserId string `json:"-"`
+	ListId string `json:"-"`
+	Name   string `json:"name"`
+}
+
+type RenameReadingListResponse struct {
+	Message string `json:"message"`
+}
+
+type DeleteReadingListRequest struct {
+	UserId string `json:"-"`
+	ListId string `json:"-"`
+}
+
+type DeleteReadingListResponse struct {
+	Message string `json:"message"`
+}
+
+type AddToReadingListRequest struct {
+	UserId   string `json:"-"`
+	ListId   string `json:"-"`
+	PostId   string `json:"-"`
+	Position *int   `json:"position,omitempty"`
+}
+
+type AddToReadingListResponse struct {
+	Message string `json:"message"`
+}
+
+type RemoveFromReadingListRequest struct {
+	UserId string `json:"-"`
+	ListId string `json:"-"`
+	PostId string `json:"-"`
+}
+
+type RemoveFromReadingListResponse struct {
+	Message string `json:"message"`
+}
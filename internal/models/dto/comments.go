@@ -0,0 +1,61 @@
+package dto
+
+import "blog/internal/models/entities"
+
+type CreateCommentRequest struct {
+	AuthorId string  `json:"-"`
+	PostId   string  `json:"-"`
+	ParentId *string `json:"parent_id,omitempty"`
+	Content  string  `json:"content"`
+}
+
+type CreateCommentResponse struct {
+	Message string           `json:"message"`
+	Comment entities.Comment `json:"comment"`
+}
+
+type EditCommentRequest struct {
+	AuthorId  string `json:"-"`
+	PostId    string `json:"-"`
+	CommentId string `json:"-"`
+	Content   string `json:"content"`
+}
+
+type EditCommentResponse struct {
+	Message string `json:"message"`
+}
+
+type DeleteCommentRequest struct {
+	UserId    string `json:"-"`
+	PostId    string `json:"-"`
+	CommentId string `json:"-"`
+}
+
+type DeleteCommentResponse struct {
+	Message string `json:"message"`
+}
+
+type GetCommentsRequest struct {
+	UserId string `json:"-"`
+	PostId string `json:"-"`
+	Limit  int    `json:"-"`
+	Cursor string `json:"-"`
+}
+
+type GetCommentsResponse struct {
+	Comments   []entities.Comment `json:"comments"`
+	NextCursor string             `json:"next_cursor,omitempty"`
+}
+
+type GetCommentRepliesRequest struct {
+	UserId    string `json:"-"`
+	PostId    string `json:"-"`
+	CommentId string `json:"-"`
+	Limit     int    `json:"-"`
+	Cursor    string `json:"-"`
+}
+
+type GetCommentRepliesResponse struct {
+	Replies    []entities.Comment `json:"replies"`
+	NextCursor string             `json:"next_cursor,omitempty"`
+}
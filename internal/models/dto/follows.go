@@ -0,0 +1,38 @@
+package dto
+
+import "blog/internal/models/entities"
+
+type FollowAuthorRequest struct {
+	UserId   string `json:"-"`
+	AuthorId string `json:"-"`
+}
+
+type FollowAuthorResponse struct {
+	Message string `json:"message"`
+}
+
+type UnfollowAuthorRequest struct {
+	UserId   string `json:"-"`
+	AuthorId string `json:"-"`
+}
+
+type UnfollowAuthorResponse struct {
+	Message string `json:"message"`
+}
+
+type GetFollowsRequest struct {
+	AuthorId string `json:"-"`
+	Limit    int    `json:"-"`
+	Cursor   string `json:"-"`
+}
+
+type GetFollowsResponse struct {
+	Users      []entities.Follow `json:"users"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+}
+
+type GetFeedRequest struct {
+	UserId string `json:"-"`
+	Limit  int    `json:"-"`
+	Cursor string `json:"-"`
+}
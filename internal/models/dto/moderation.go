@@ -0,0 +1,35 @@
+package dto
+
+import "blog/internal/models/entities"
+
+type GetModerationQueueRequest struct {
+	AuthorId string `json:"-"`
+	Status   string `json:"-"`
+	Limit    int    `json:"-"`
+	Cursor   string `json:"-"`
+}
+
+type GetModerationQueueResponse struct {
+	Comments   []entities.Comment `json:"comments"`
+	NextCursor string             `json:"next_cursor,omitempty"`
+}
+
+type ModerateCommentRequest struct {
+	AuthorId  string `json:"-"`
+	CommentId string `json:"-"`
+	Status    string `json:"status"`
+}
+
+type ModerateCommentResponse struct {
+	Message string `json:"message"`
+}
+
+type ApproveCommentsRequest struct {
+	AuthorId   string   `json:"-"`
+	CommentIds []string `json:"comment_ids"`
+}
+
+type ApproveCommentsResponse struct {
+	Message  string `json:"message"`
+	Approved int    `json:"approved"`
+}
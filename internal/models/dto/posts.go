@@ -1,42 +1,132 @@
 package dto
 
-import "blog/internal/models/entities"
+import (
+	"blog/internal/models/entities"
+	"time"
+)
 
 type CreatePostRequest struct {
-	AuthorId       string `json:"-"`
-	IdempotencyKey string `json:"idempotency_key"`
-	Title          string `json:"title"`
-	Content        string `json:"content"`
+	AuthorId       string   `json:"-"`
+	IdempotencyKey string   `json:"idempotency_key"`
+	Title          string   `json:"title"`
+	Content        string   `json:"content"`
+	ContentFormat  string   `json:"content_format"`
+	Language       string   `json:"language"`
+	Tags           []string `json:"tags"`
 }
 
 type CreatePostResponse struct {
 	Message string `json:"message"`
 }
 type EditPostRequest struct {
-	AuthorId string `json:"-"`
-	PostId   string `json:"-"`
-	Title    string `json:"title"`
-	Content  string `json:"content"`
+	AuthorId      string   `json:"-"`
+	PostId        string   `json:"-"`
+	Version       int      `json:"-"`
+	Title         string   `json:"title"`
+	Content       string   `json:"content"`
+	ContentFormat string   `json:"content_format"`
+	Language      string   `json:"language"`
+	Tags          []string `json:"tags"`
 }
 
 type EditPostResponse struct {
 	Message string `json:"message"`
+	Version int    `json:"version"`
 }
 
 type PublishPostRequest struct {
+	AuthorId      string     `json:"-"`
+	EmailVerified bool       `json:"-"`
+	PostId        string     `json:"-"`
+	Version       int        `json:"-"`
+	Status        string     `json:"status"`
+	PublishAt     *time.Time `json:"publish_at,omitempty"`
+}
+
+type PublishPostResponse struct {
+	Message string `json:"message"`
+	Version int    `json:"version"`
+}
+
+type GetPostRequest struct {
+	UserId       string `json:"-"`
+	PostIdOrSlug string `json:"-"`
+}
+
+type GetPostResponse struct {
+	Post     entities.Post `json:"post"`
+	Redirect string        `json:"-"`
+}
+
+type DeletePostRequest struct {
 	AuthorId string `json:"-"`
 	PostId   string `json:"-"`
-	Status   string `json:"status"`
 }
 
-type PublishPostResponse struct {
+type DeletePostResponse struct {
 	Message string `json:"message"`
 }
 
+type RestorePostRequest struct {
+	AuthorId string `json:"-"`
+	PostId   string `json:"-"`
+}
+
+type RestorePostResponse struct {
+	Message string `json:"message"`
+}
+
+type GetTrashRequest struct {
+	AuthorId string `json:"-"`
+}
+
+type PostsQuery struct {
+	Limit  int        `json:"-"`
+	Cursor string     `json:"-"`
+	Sort   string     `json:"-"`
+	Order  string     `json:"-"`
+	Status string     `json:"-"`
+	Author string     `json:"-"`
+	From   *time.Time `json:"-"`
+	To     *time.Time `json:"-"`
+}
+
+type PostsFilter struct {
+	AuthorId     string
+	FollowerId   string
+	Status       string
+	Tag          string
+	From         *time.Time
+	To           *time.Time
+	SortBy       string
+	Descending   bool
+	CursorValue  *time.Time
+	CursorPostId string
+	Limit        int
+}
+
 type GetPostsByIdRequest struct {
 	AuthorId string `json:"-"`
+	PostsQuery
+}
+
+type GetAllPostsRequest struct {
+	PostsQuery
 }
 
 type GetPostsResponse struct {
-	Posts []entities.Post `json:"posts"`
+	Posts      []entities.Post `json:"posts"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+}
+
+type SearchPostsRequest struct {
+	Query    string `json:"-"`
+	Language string `json:"-"`
+	Limit    int    `json:"-"`
+	Offset   int    `json:"-"`
+}
+
+type SearchPostsResponse struct {
+	Results    []entities.SearchResult `json:"results"`
+	NextOffset int                     `json:"next_offset,omitempty"`
 }
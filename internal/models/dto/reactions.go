@@ -0,0 +1,26 @@
+package dto
+
+import "blog/internal/models/entities"
+
+type ToggleReactionRequest struct {
+	UserId   string `json:"-"`
+	PostId   string `json:"-"`
+	Reaction string `json:"-"`
+}
+
+type ToggleReactionResponse struct {
+	Message   string         `json:"message"`
+	Reacted   bool           `json:"reacted"`
+	Reactions map[string]int `json:"reactions"`
+}
+
+type GetReactedPostsRequest struct {
+	UserId string `json:"-"`
+	Limit  int    `json:"-"`
+	Cursor string `json:"-"`
+}
+
+type GetReactedPostsResponse struct {
+	Posts      []entities.ReactedPost `json:"posts"`
+	NextCursor string                 `json:"next_cursor,omitempty"`
+}
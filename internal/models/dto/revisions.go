@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"blog/internal/models/entities"
+	"blog/pkg/utils/diff"
+)
+
+type GetRevisionsRequest struct {
+	AuthorId string `json:"-"`
+	PostId   string `json:"-"`
+}
+
+type GetRevisionsResponse struct {
+	Revisions []entities.Revision `json:"revisions"`
+}
+
+type GetRevisionRequest struct {
+	AuthorId   string `json:"-"`
+	PostId     string `json:"-"`
+	RevisionId string `json:"-"`
+}
+
+type GetRevisionResponse struct {
+	Revision entities.Revision `json:"revision"`
+}
+
+type DiffRevisionsRequest struct {
+	AuthorId string `json:"-"`
+	PostId   string `json:"-"`
+	From     string `json:"-"`
+	To       string `json:"-"`
+}
+
+type DiffRevisionsResponse struct {
+	From    string      `json:"from"`
+	To      string      `json:"to"`
+	Title   []diff.Line `json:"title"`
+	Content []diff.Line `json:"content"`
+}
+
+type RestoreRevisionRequest struct {
+	AuthorId   string `json:"-"`
+	PostId     string `json:"-"`
+	RevisionId string `json:"-"`
+	Version    int    `json:"-"`
+}
+
+type RestoreRevisionResponse struct {
+	Message string `json:"message"`
+	Version int    `json:"version"`
+}
@@ -0,0 +1,21 @@
+package dto
+
+import "blog/internal/models/entities"
+
+type GetSessionsRequest struct {
+	UserId           string `json:"-"`
+	CurrentSessionId string `json:"-"`
+}
+
+type GetSessionsResponse struct {
+	Sessions []entities.Session `json:"sessions"`
+}
+
+type RevokeSessionRequest struct {
+	UserId    string `json:"-"`
+	SessionId string `json:"-"`
+}
+
+type RevokeSessionResponse struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,13 @@
+package dto
+
+type GetSitemapRequest struct {
+	File string `json:"-"`
+}
+
+type GetSitemapResponse struct {
+	Body []byte
+}
+
+type GetRobotsResponse struct {
+	Body string
+}
@@ -0,0 +1,12 @@
+package dto
+
+import "blog/internal/models/entities"
+
+type GetStatusHistoryRequest struct {
+	AuthorId string `json:"-"`
+	PostId   string `json:"-"`
+}
+
+type GetStatusHistoryResponse struct {
+	Transitions []entities.StatusTransition `json:"transitions"`
+}
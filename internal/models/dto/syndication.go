@@ -0,0 +1,12 @@
+package dto
+
+type GetSyndicationFeedRequest struct {
+	AuthorId string `json:"-"`
+	Tag      string `json:"-"`
+	Format   string `json:"-"`
+}
+
+type GetSyndicationFeedResponse struct {
+	ContentType string
+	Body        []byte
+}
@@ -0,0 +1,12 @@
+package dto
+
+import "blog/internal/models/entities"
+
+type GetTagsResponse struct {
+	Tags []entities.Tag `json:"tags"`
+}
+
+type GetTagPostsRequest struct {
+	Slug string `json:"-"`
+	PostsQuery
+}
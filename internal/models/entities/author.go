@@ -0,0 +1,11 @@
+package entities
+
+import "time"
+
+type Author struct {
+	AuthorId       string     `json:"author_id"`
+	PostsCount     int        `json:"posts_count"`
+	FollowersCount int        `json:"followers_count"`
+	FollowingCount int        `json:"following_count"`
+	LatestPostAt   *time.Time `json:"latest_post_at,omitempty"`
+}
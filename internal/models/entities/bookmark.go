@@ -0,0 +1,18 @@
+package entities
+
+import "time"
+
+type BookmarkedPost struct {
+	Post         Post      `json:"post"`
+	BookmarkedAt time.Time `json:"bookmarked_at"`
+}
+
+type ReadingList struct {
+	ListId     string    `json:"list_id"`
+	UserId     string    `json:"user_id"`
+	Name       string    `json:"name"`
+	PostsCount int       `json:"posts_count"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	Posts      []Post    `json:"posts,omitempty"`
+}
@@ -0,0 +1,18 @@
+package entities
+
+import "time"
+
+type Comment struct {
+	CommentId     string     `json:"comment_id"`
+	PostId        string     `json:"post_id"`
+	AuthorId      string     `json:"author_id"`
+	ParentId      *string    `json:"parent_id,omitempty"`
+	Content       string     `json:"content"`
+	Status        string     `json:"status"`
+	SpamReason    string     `json:"spam_reason,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
+	Replies       []Comment  `json:"replies"`
+	RepliesCursor string     `json:"replies_cursor,omitempty"`
+}
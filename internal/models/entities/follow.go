@@ -0,0 +1,8 @@
+package entities
+
+import "time"
+
+type Follow struct {
+	UserId     string    `json:"user_id"`
+	FollowedAt time.Time `json:"followed_at"`
+}
@@ -3,13 +3,23 @@ package entities
 import "time"
 
 type Post struct {
-	PostId         string    `json:"post_id"`
-	AuthorId       string    `json:"author_id"`
-	IdempotencyKey string    `json:"idempotency_key"`
-	Title          string    `json:"title"`
-	Content        string    `json:"content"`
-	Status         string    `json:"status"`
-	CreatedAt      time.Time `json:"created_at"`
-	UpdatedAt      time.Time `json:"updated_at"`
-	Images         []Image   `json:"images"`
+	PostId         string         `json:"post_id"`
+	AuthorId       string         `json:"author_id"`
+	IdempotencyKey string         `json:"idempotency_key"`
+	Slug           string         `json:"slug"`
+	Title          string         `json:"title"`
+	Content        string         `json:"content"`
+	ContentFormat  string         `json:"content_format"`
+	ContentHTML    string         `json:"content_html"`
+	Status         string         `json:"status"`
+	Language       string         `json:"language"`
+	Version        int            `json:"version"`
+	PublishAt      *time.Time     `json:"publish_at,omitempty"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+	DeletedAt      *time.Time     `json:"deleted_at,omitempty"`
+	Images         []Image        `json:"images"`
+	Tags           []Tag          `json:"tags"`
+	CommentsCount  int            `json:"comments_count"`
+	Reactions      map[string]int `json:"reactions"`
 }
@@ -0,0 +1,9 @@
+package entities
+
+import "time"
+
+type ReactedPost struct {
+	Post      Post      `json:"post"`
+	Reactions []string  `json:"reactions"`
+	ReactedAt time.Time `json:"reacted_at"`
+}
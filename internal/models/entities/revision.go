@@ -0,0 +1,13 @@
+package entities
+
+import "time"
+
+type Revision struct {
+	RevisionId    string    `json:"revision_id"`
+	PostId        string    `json:"post_id"`
+	AuthorId      string    `json:"author_id"`
+	Title         string    `json:"title"`
+	Content       string    `json:"content"`
+	ContentFormat string    `json:"content_format"`
+	CreatedAt     time.Time `json:"created_at"`
+}
@@ -0,0 +1,7 @@
+package entities
+
+type SearchResult struct {
+	Post     Post    `json:"post"`
+	Rank     float64 `json:"rank"`
+	Headline string  `json:"headline"`
+}
@@ -0,0 +1,16 @@
+package entities
+
+import "time"
+
+type Session struct {
+	SessionId  string     `json:"session_id"`
+	UserId     string     `json:"-"`
+	Device     string     `json:"device"`
+	UserAgent  string     `json:"user_agent"`
+	IP         string     `json:"ip"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt time.Time  `json:"last_used_at"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	RevokedAt  *time.Time `json:"-"`
+	Current    bool       `json:"current"`
+}
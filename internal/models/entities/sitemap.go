@@ -0,0 +1,9 @@
+package entities
+
+import "time"
+
+type SitemapEntry struct {
+	Kind      string
+	Key       string
+	UpdatedAt time.Time
+}
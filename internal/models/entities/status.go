@@ -0,0 +1,12 @@
+package entities
+
+import "time"
+
+type StatusTransition struct {
+	TransitionId string    `json:"transition_id"`
+	PostId       string    `json:"post_id"`
+	ChangedBy    string    `json:"changed_by"`
+	FromStatus   string    `json:"from_status"`
+	ToStatus     string    `json:"to_status"`
+	ChangedAt    time.Time `json:"changed_at"`
+}
@@ -0,0 +1,8 @@
+package entities
+
+type Tag struct {
+	TagId      string `json:"tag_id"`
+	Slug       string `json:"slug"`
+	Name       string `json:"name"`
+	PostsCount int    `json:"posts_count,omitempty"`
+}
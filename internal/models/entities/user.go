@@ -3,10 +3,11 @@ package entities
 import "time"
 
 type User struct {
-	UserId                 string    `json:"user_id"`
-	Email                  string    `json:"email"`
-	PasswordHash           string    `json:"password_hash"`
-	Role                   string    `json:"role"`
-	RefreshToken           string    `json:"refresh_token"`
-	RefreshTokenExpiryTime time.Time `json:"refresh_token_expiry_time"`
+	UserId           string     `json:"user_id"`
+	Email            string     `json:"email"`
+	PasswordHash     string     `json:"password_hash"`
+	Role             string     `json:"role"`
+	TokensValidAfter *time.Time `json:"tokens_valid_after,omitempty"`
+	EmailVerifiedAt  *time.Time `json:"email_verified_at,omitempty"`
+	SessionId        string     `json:"-"`
 }
@@ -0,0 +1,83 @@
+package moderation
+
+import (
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"regexp"
+	"strings"
+	"time"
+)
+
+type Verdict struct {
+	Status string
+	Reason string
+}
+
+type SpamChecker interface {
+	Check(comment *entities.Comment) (*Verdict, error)
+}
+
+type HeuristicCheckerConfig struct {
+	MaxLinks        int           `env:"COMMENT_MAX_LINKS" env-default:"2"`
+	BlockedWords    []string      `env:"COMMENT_BLOCKED_WORDS" env-separator:","`
+	DuplicateWindow time.Duration `env:"COMMENT_DUPLICATE_WINDOW" env-default:"24h"`
+}
+
+type CommentsHistory interface {
+	CountDuplicateComments(authorId, commentId, content string, since time.Time) (int, error)
+}
+
+// HeuristicChecker rejects comments with blocklisted words or repeating the
+// author's recent comments and holds comments with too many links for review.
+type HeuristicChecker struct {
+	cfg          HeuristicCheckerConfig
+	history      CommentsHistory
+	blockedWords *regexp.Regexp
+}
+
+var linkRe = regexp.MustCompile(`(?i)\b(?:https?://|www\.)`)
+
+func NewHeuristicChecker(cfg HeuristicCheckerConfig, history CommentsHistory) *HeuristicChecker {
+	checker := &HeuristicChecker{
+		cfg:     cfg,
+		history: history,
+	}
+
+	var words []string
+	for _, word := range cfg.BlockedWords {
+		if word = strings.TrimSpace(word); word != "" {
+			words = append(words, regexp.QuoteMeta(word))
+		}
+	}
+	// Word boundaries are spelled out because \b only knows ASCII letters and
+	// never matches around Cyrillic words.
+	if len(words) > 0 {
+		checker.blockedWords = regexp.MustCompile(`(?i)(?:^|[^\p{L}\p{N}_])(` + strings.Join(words, "|") + `)(?:$|[^\p{L}\p{N}_])`)
+	}
+
+	return checker
+}
+
+func (c *HeuristicChecker) Check(comment *entities.Comment) (*Verdict, error) {
+	if c.blockedWords != nil {
+		if match := c.blockedWords.FindStringSubmatch(comment.Content); match != nil {
+			return &Verdict{Status: consts.RejectedCommentState, Reason: "blocked word: " + strings.ToLower(match[1])}, nil
+		}
+	}
+
+	if c.cfg.DuplicateWindow > 0 {
+		duplicates, err := c.history.CountDuplicateComments(comment.AuthorId, comment.CommentId, comment.Content, time.Now().Add(-c.cfg.DuplicateWindow))
+		if err != nil {
+			return nil, err
+		}
+		if duplicates > 0 {
+			return &Verdict{Status: consts.RejectedCommentState, Reason: "duplicate content"}, nil
+		}
+	}
+
+	if links := len(linkRe.FindAllStringIndex(comment.Content, -1)); links > c.cfg.MaxLinks {
+		return &Verdict{Status: consts.PendingCommentState, Reason: "too many links"}, nil
+	}
+
+	return &Verdict{Status: consts.ApprovedCommentState}, nil
+}
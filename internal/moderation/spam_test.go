@@ -0,0 +1,97 @@
+package moderation
+
+import (
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeHistory struct {
+	duplicates int
+}
+
+func (h *fakeHistory) CountDuplicateComments(authorId, commentId, content string, since time.Time) (int, error) {
+	return h.duplicates, nil
+}
+
+func TestHeuristicChecker_Check(t *testing.T) {
+	cfg := HeuristicCheckerConfig{
+		MaxLinks:        2,
+		BlockedWords:    []string{"casino", " cheap pills ", "казино"},
+		DuplicateWindow: time.Hour,
+	}
+
+	tests := []struct {
+		name           string
+		content        string
+		duplicates     int
+		expectedStatus string
+		expectedReason string
+	}{
+		{
+			name:           "clean",
+			content:        "Great post, see https://go.dev for more",
+			expectedStatus: consts.ApprovedCommentState,
+		},
+		{
+			name:           "blocked word",
+			content:        "Visit our CASINO today",
+			expectedStatus: consts.RejectedCommentState,
+			expectedReason: "blocked word: casino",
+		},
+		{
+			name:           "blocked phrase",
+			content:        "buy cheap pills here",
+			expectedStatus: consts.RejectedCommentState,
+			expectedReason: "blocked word: cheap pills",
+		},
+		{
+			name:           "blocked word inside another word",
+			content:        "casinos are not blocked as a whole word",
+			expectedStatus: consts.ApprovedCommentState,
+		},
+		{
+			name:           "cyrillic blocked word",
+			content:        "Лучшее КАЗИНО, заходите!",
+			expectedStatus: consts.RejectedCommentState,
+			expectedReason: "blocked word: казино",
+		},
+		{
+			name:           "cyrillic blocked word inside another word",
+			content:        "онлайнказино не совпадает целиком",
+			expectedStatus: consts.ApprovedCommentState,
+		},
+		{
+			name:           "blocked word at the start",
+			content:        "casino",
+			expectedStatus: consts.RejectedCommentState,
+			expectedReason: "blocked word: casino",
+		},
+		{
+			name:           "duplicate",
+			content:        "first!",
+			duplicates:     1,
+			expectedStatus: consts.RejectedCommentState,
+			expectedReason: "duplicate content",
+		},
+		{
+			name:           "too many links",
+			content:        "http://a.example https://b.example www.c.example",
+			expectedStatus: consts.PendingCommentState,
+			expectedReason: "too many links",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			checker := NewHeuristicChecker(cfg, &fakeHistory{duplicates: test.duplicates})
+
+			verdict, err := checker.Check(&entities.Comment{AuthorId: "authorId", Content: test.content})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatus, verdict.Status)
+			assert.Equal(t, test.expectedReason, verdict.Reason)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"database/sql"
+	stderr "errors"
+	"log"
+)
+
+func (r *BlogRepository) GetAuthorById(authorId string) (*entities.Author, error) {
+	var author entities.Author
+
+	query := `SELECT users.user_id, COUNT(posts.post_id), MAX(posts.created_at),
+		(SELECT COUNT(*) FROM follows WHERE follows.author_id = users.user_id),
+		(SELECT COUNT(*) FROM follows WHERE follows.follower_id = users.user_id)
+	FROM users
+	LEFT JOIN posts ON posts.author_id = users.user_id AND posts.status = $2 AND posts.deleted_at IS NULL
+	WHERE users.user_id = $1 AND users.role = $3
+	GROUP BY users.user_id`
+	err := r.DB.QueryRow(query, authorId, consts.PublishedState, consts.AuthorRole).Scan(&author.AuthorId, &author.PostsCount, &author.LatestPostAt, &author.FollowersCount, &author.FollowingCount)
+	if err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrAuthorNotFound
+		}
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return &author, nil
+}
@@ -1,12 +1,16 @@
 package repository
 
 import (
+	"blog/internal/models/dto"
 	"blog/internal/models/entities"
 	"blog/pkg/consts"
 	"blog/pkg/consts/errors"
 	"database/sql"
 	stderr "errors"
+	"fmt"
+	"html"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/lib/pq"
@@ -22,13 +26,18 @@ func NewBlogRepository(db *sql.DB) *BlogRepository {
 	}
 }
 
-func (r *BlogRepository) CreateUser(email, passwordHash, role, refreshToken string, refreshTokenExpiryTime time.Time) (*entities.User, error) {
+const userColumns = `user_id, email, password_hash, role, tokens_valid_after, email_verified_at`
+
+func scanUser(row rowScanner, user *entities.User) error {
+	return row.Scan(&user.UserId, &user.Email, &user.PasswordHash, &user.Role, &user.TokensValidAfter, &user.EmailVerifiedAt)
+}
+
+func (r *BlogRepository) CreateUser(email, passwordHash, role string) (*entities.User, error) {
 	var user entities.User
 
-	query := `INSERT INTO users (email, password_hash, role, refresh_token, refresh_token_expiry_time) VALUES ($1, $2, $3, $4, $5) RETURNING *`
+	query := `INSERT INTO users (email, password_hash, role) VALUES ($1, $2, $3) RETURNING ` + userColumns
 
-	err := r.DB.QueryRow(query, email, passwordHash, role, refreshToken, refreshTokenExpiryTime).
-		Scan(&user.UserId, &user.Email, &user.PasswordHash, &user.Role, &user.RefreshToken, &user.RefreshTokenExpiryTime)
+	err := scanUser(r.DB.QueryRow(query, email, passwordHash, role), &user)
 	if err != nil {
 		pgErr, ok := err.(*pq.Error)
 		if ok && pgErr.Code == "23505" {
@@ -44,8 +53,8 @@ func (r *BlogRepository) CreateUser(email, passwordHash, role, refreshToken stri
 func (r *BlogRepository) GetUserByEmail(email string) (*entities.User, error) {
 	var user entities.User
 
-	query := `SELECT * FROM users WHERE email = $1`
-	err := r.DB.QueryRow(query, email).Scan(&user.UserId, &user.Email, &user.PasswordHash, &user.Role, &user.RefreshToken, &user.RefreshTokenExpiryTime)
+	query := `SELECT ` + userColumns + ` FROM users WHERE email = $1`
+	err := scanUser(r.DB.QueryRow(query, email), &user)
 	if err != nil {
 		if stderr.Is(err, sql.ErrNoRows) {
 			return nil, errors.ErrInvalidEmailOrPassword
@@ -57,15 +66,14 @@ func (r *BlogRepository) GetUserByEmail(email string) (*entities.User, error) {
 	return &user, nil
 }
 
-func (r *BlogRepository) GetUserByRefreshToken(refreshToken string) (*entities.User, error) {
+func (r *BlogRepository) GetUserById(userId string) (*entities.User, error) {
 	var user entities.User
 
-	query := `SELECT * FROM users WHERE refresh_token = $1`
-	err := r.DB.QueryRow(query, refreshToken).
-		Scan(&user.UserId, &user.Email, &user.PasswordHash, &user.Role, &user.RefreshToken, &user.RefreshTokenExpiryTime)
+	query := `SELECT ` + userColumns + ` FROM users WHERE user_id = $1`
+	err := scanUser(r.DB.QueryRow(query, userId), &user)
 	if err != nil {
 		if stderr.Is(err, sql.ErrNoRows) {
-			return nil, errors.ErrInvalidRefreshToken
+			return nil, errors.ErrInvalidAccessToken
 		}
 		log.Println(err)
 		return nil, errors.ErrInternalServerError
@@ -74,60 +82,70 @@ func (r *BlogRepository) GetUserByRefreshToken(refreshToken string) (*entities.U
 	return &user, nil
 }
 
-func (r *BlogRepository) GetUserById(userId string) (*entities.User, error) {
-	var user entities.User
+const postColumns = `post_id, author_id, idempotency_key, slug, title, content, content_format, content_html, status, language, version, publish_at, created_at, updated_at, deleted_at`
 
-	query := `SELECT * FROM users WHERE user_id = $1`
-	err := r.DB.QueryRow(query, userId).
-		Scan(&user.UserId, &user.Email, &user.PasswordHash, &user.Role, &user.RefreshToken, &user.RefreshTokenExpiryTime)
-	if err != nil {
-		if stderr.Is(err, sql.ErrNoRows) {
-			return nil, errors.ErrInvalidAccessToken
-		}
-		log.Println(err)
-		return nil, errors.ErrInternalServerError
-	}
+const postsSlugConstraint = "posts_slug_key"
 
-	return &user, nil
+type rowScanner interface {
+	Scan(dest ...any) error
 }
 
-func (r *BlogRepository) UpdateRefreshToken(userId, refreshToken string) error {
-	query := `UPDATE users SET refresh_token = $1 WHERE user_id = $2`
-	_, err := r.DB.Exec(query, refreshToken, userId)
-	if err != nil {
-		if stderr.Is(err, sql.ErrNoRows) {
-			return errors.ErrInvalidUserId
-		}
-		log.Println(err)
-		return errors.ErrInternalServerError
-	}
+func postFields(post *entities.Post) []any {
+	return []any{&post.PostId, &post.AuthorId, &post.IdempotencyKey, &post.Slug, &post.Title, &post.Content, &post.ContentFormat, &post.ContentHTML, &post.Status, &post.Language, &post.Version, &post.PublishAt, &post.CreatedAt, &post.UpdatedAt, &post.DeletedAt}
+}
 
-	return nil
+func scanPost(row rowScanner, post *entities.Post) error {
+	return row.Scan(postFields(post)...)
 }
 
-func (r *BlogRepository) CreatePost(authorId, idempotencyKey, title, content, status string, createdAt, updatedAt time.Time) (*entities.Post, error) {
+// CreatePost inserts the post together with its tags and its first revision.
+func (r *BlogRepository) CreatePost(authorId, idempotencyKey, slug, title, content, contentFormat, contentHTML, status, language string, tags []entities.Tag, createdAt, updatedAt time.Time) (*entities.Post, error) {
 	var post entities.Post
 
-	query := `INSERT INTO posts (author_id, idempotency_key, title, content, status, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *`
-	err := r.DB.QueryRow(query, authorId, idempotencyKey, title, content, status, createdAt, updatedAt).
-		Scan(&post.PostId, &post.AuthorId, &post.IdempotencyKey, &post.Title, &post.Content, &post.Status, &post.CreatedAt, &post.UpdatedAt)
+	tx, err := r.DB.Begin()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO posts (author_id, idempotency_key, slug, title, content, content_format, content_html, status, language, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING ` + postColumns
+	err = scanPost(tx.QueryRow(query, authorId, idempotencyKey, slug, title, content, contentFormat, contentHTML, status, language, createdAt, updatedAt), &post)
 	if err != nil {
 		pgErr, ok := err.(*pq.Error)
 		if ok && pgErr.Code == "23505" {
+			if pgErr.Constraint == postsSlugConstraint {
+				return nil, errors.ErrSlugAlreadyExists
+			}
 			return nil, errors.ErrInvalidIdempotencyKey
 		}
 		log.Println(err)
 		return nil, errors.ErrInternalServerError
 	}
 
+	if len(tags) > 0 {
+		if _, err = replacePostTags(tx, post.PostId, tags); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err = insertPostRevision(tx, post.PostId, post.AuthorId, post.Title, post.Content, post.ContentFormat, post.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
 	return &post, nil
 }
 
 func (r *BlogRepository) GetPostById(postId string) (*entities.Post, error) {
 	var post entities.Post
 
-	query := `SELECT * FROM posts WHERE post_id = $1`
-	err := r.DB.QueryRow(query, postId).Scan(&post.PostId, &post.AuthorId, &post.IdempotencyKey, &post.Title, &post.Content, &post.Status, &post.CreatedAt, &post.UpdatedAt)
+	query := `SELECT ` + postColumns + ` FROM posts WHERE post_id = $1`
+	err := scanPost(r.DB.QueryRow(query, postId), &post)
 	if err != nil {
 		if stderr.Is(err, sql.ErrNoRows) {
 			return nil, errors.ErrInvalidPostId
@@ -139,106 +157,364 @@ func (r *BlogRepository) GetPostById(postId string) (*entities.Post, error) {
 	return &post, nil
 }
 
-func (r *BlogRepository) EditPost(postId, authorId, idempotencyKey, title, content, status string, createdAt, updatedAt time.Time) (*entities.Post, error) {
+func (r *BlogRepository) GetPostWithRelationsById(postId string) (*entities.Post, error) {
+	post, err := r.GetPostById(postId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.loadPostsRelations([]*entities.Post{post}); err != nil {
+		return nil, err
+	}
+
+	return post, nil
+}
+
+// EditPost keeps the previous slug as a redirect when it changes, so links
+// shared before a title edit keep resolving to the post.
+// EditPost updates the post, replaces its tags unless they are nil and saves
+// the new content as a revision, bumping the version once.
+func (r *BlogRepository) EditPost(postId, authorId, idempotencyKey, slug, title, content, contentFormat, contentHTML, status, language string, tags []entities.Tag, version int, createdAt, updatedAt time.Time) (*entities.Post, error) {
 	var post entities.Post
+	var previousSlug string
+
+	tx, err := r.DB.Begin()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+	defer tx.Rollback()
 
-	query := `UPDATE posts SET author_id = $1, idempotency_key = $2, title = $3, content = $4, status = $5, created_at = $6, updated_at = $7 WHERE post_id = $8 RETURNING *`
-	err := r.DB.QueryRow(query, authorId, idempotencyKey, title, content, status, createdAt, updatedAt, postId).
-		Scan(&post.PostId, &post.AuthorId, &post.IdempotencyKey, &post.Title, &post.Content, &post.Status, &post.CreatedAt, &post.UpdatedAt)
+	query := `UPDATE posts SET author_id = $1, idempotency_key = $2, slug = $3, title = $4, content = $5, content_format = $6, content_html = $7, status = $8, language = $9, created_at = $10, updated_at = $11, version = version + 1
+	FROM (SELECT slug AS previous_slug FROM posts WHERE post_id = $12) AS previous
+	WHERE post_id = $12 AND version = $13 RETURNING previous.previous_slug, ` + postColumns
+	fields := append([]any{&previousSlug}, postFields(&post)...)
+	err = tx.QueryRow(query, authorId, idempotencyKey, slug, title, content, contentFormat, contentHTML, status, language, createdAt, updatedAt, postId, version).Scan(fields...)
 	if err != nil {
 		if stderr.Is(err, sql.ErrNoRows) {
-			return nil, errors.ErrInvalidPostId
+			return nil, errors.ErrPostVersionMismatch
+		}
+		pgErr, ok := err.(*pq.Error)
+		if ok && pgErr.Code == "23505" && pgErr.Constraint == postsSlugConstraint {
+			return nil, errors.ErrSlugAlreadyExists
 		}
 		log.Println(err)
 		return nil, errors.ErrInternalServerError
 	}
 
+	if previousSlug != slug {
+		query = `DELETE FROM post_slug_redirects WHERE slug = $1 AND post_id = $2`
+		if _, err = tx.Exec(query, slug, postId); err != nil {
+			log.Println(err)
+			return nil, errors.ErrInternalServerError
+		}
+
+		query = `INSERT INTO post_slug_redirects (slug, post_id) VALUES ($1, $2) ON CONFLICT (slug) DO NOTHING`
+		if _, err = tx.Exec(query, previousSlug, postId); err != nil {
+			log.Println(err)
+			return nil, errors.ErrInternalServerError
+		}
+	}
+
+	if tags != nil {
+		if _, err = replacePostTags(tx, postId, tags); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err = insertPostRevision(tx, post.PostId, authorId, post.Title, post.Content, post.ContentFormat, post.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
 	return &post, nil
 }
 
-func (r *BlogRepository) GetPostsByUserId(userId string) ([]*entities.Post, error) {
-	var posts []*entities.Post
+func (r *BlogRepository) TrashPost(postId string, deletedAt time.Time) error {
+	query := `UPDATE posts SET deleted_at = $1 WHERE post_id = $2 AND deleted_at IS NULL`
+	result, err := r.DB.Exec(query, deletedAt, postId)
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
 
-	query := `SELECT * FROM posts WHERE author_id = $1`
-	rows, err := r.DB.Query(query, userId)
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	if affected == 0 {
+		return errors.ErrInvalidPostId
+	}
+
+	return nil
+}
+
+func (r *BlogRepository) RestorePost(postId string) error {
+	query := `UPDATE posts SET deleted_at = NULL WHERE post_id = $1 AND deleted_at IS NOT NULL`
+	result, err := r.DB.Exec(query, postId)
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	if affected == 0 {
+		return errors.ErrInvalidPostId
+	}
+
+	return nil
+}
+
+func (r *BlogRepository) DeletePost(postId string) error {
+	query := `DELETE FROM posts WHERE post_id = $1`
+	result, err := r.DB.Exec(query, postId)
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	if affected == 0 {
+		return errors.ErrInvalidPostId
+	}
+
+	return nil
+}
+
+func (r *BlogRepository) GetPostsByUserId(userId string, filter *dto.PostsFilter) ([]*entities.Post, error) {
+	query, args := buildPostsQuery([]string{"author_id = $1", "deleted_at IS NULL"}, []any{userId}, filter)
+	return r.queryPosts(query, args...)
+}
+
+func (r *BlogRepository) GetAllPosts(filter *dto.PostsFilter) ([]*entities.Post, error) {
+	query, args := buildPostsQuery([]string{"status = $1", "deleted_at IS NULL"}, []any{consts.PublishedState}, filter)
+	return r.queryPosts(query, args...)
+}
+
+func (r *BlogRepository) GetTrashedPostsByUserId(userId string) ([]*entities.Post, error) {
+	query := `SELECT ` + postColumns + ` FROM posts WHERE author_id = $1 AND deleted_at IS NOT NULL ORDER BY deleted_at DESC`
+	return r.queryPosts(query, userId)
+}
+
+func (r *BlogRepository) GetTrashedPostsBefore(before time.Time) ([]*entities.Post, error) {
+	query := `SELECT ` + postColumns + ` FROM posts WHERE deleted_at IS NOT NULL AND deleted_at < $1`
+	return r.queryPosts(query, before)
+}
+
+func (r *BlogRepository) SearchPosts(text, language string, limit, offset int) ([]*entities.SearchResult, error) {
+	var results []*entities.SearchResult
+
+	query := `WITH q AS (SELECT websearch_to_tsquery($2::regconfig, $1) AS query),
+	ranked AS (
+		SELECT posts.*, ts_rank(posts.search_vector, q.query) AS rank
+		FROM posts, q
+		WHERE posts.status = $3 AND posts.deleted_at IS NULL AND posts.language = $2::regconfig AND posts.search_vector @@ q.query
+		ORDER BY rank DESC, posts.created_at DESC, posts.post_id
+		LIMIT $4 OFFSET $5
+	)
+	SELECT ` + postColumns + `, rank, ts_headline(language, translate(regexp_replace(content_html, '<[^>]*>', ' ', 'g'), $6, ''), (SELECT query FROM q), $7)
+	FROM ranked
+	ORDER BY rank DESC, created_at DESC, post_id`
+	rows, err := r.DB.Query(query, text, language, consts.PublishedState, limit, offset, headlineStartSel+headlineStopSel, headlineOptions)
 	if err != nil {
-		if stderr.Is(err, sql.ErrNoRows) {
-			return nil, errors.ErrInvalidPostId
-		}
 		log.Println(err)
 		return nil, errors.ErrInternalServerError
 	}
+	defer rows.Close()
 
+	var posts []*entities.Post
 	for rows.Next() {
-		var post entities.Post
-		err = rows.Scan(&post.PostId, &post.AuthorId, &post.IdempotencyKey, &post.Title, &post.Content, &post.Status, &post.CreatedAt, &post.UpdatedAt)
+		var result entities.SearchResult
+		err = rows.Scan(append(postFields(&result.Post), &result.Rank, &result.Headline)...)
 		if err != nil {
 			log.Println(err)
 			return nil, errors.ErrInternalServerError
 		}
-		query = `SELECT * FROM images WHERE post_id = $1`
-		imageRows, err := r.DB.Query(query, post.PostId)
-		if err != nil {
-			log.Println(err)
-			return nil, errors.ErrInternalServerError
-		}
-		for imageRows.Next() {
-			var image entities.Image
-			err = imageRows.Scan(&image.ImageId, &image.PostId, &image.ImageURL, &image.CreatedAt)
-			if err != nil {
-				log.Println(err)
-				return nil, errors.ErrInternalServerError
-			}
-			post.Images = append(post.Images, image)
-		}
-		posts = append(posts, &post)
+		result.Headline = markHeadline(result.Headline)
+		results = append(results, &result)
+		posts = append(posts, &result.Post)
+	}
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
 	}
 
-	return posts, nil
+	if err = r.loadPostsRelations(posts); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// The search headline is built from the rendered content with its tags
+// stripped. Matches are delimited by private use characters, which are removed
+// from the text beforehand, and become <mark> only after the text is escaped.
+const (
+	headlineStartSel = "\uE000"
+	headlineStopSel  = "\uE001"
+)
+
+var headlineOptions = fmt.Sprintf("StartSel=%s, StopSel=%s, MaxFragments=2, MaxWords=30, MinWords=10", headlineStartSel, headlineStopSel)
+
+var headlineMarks = strings.NewReplacer(headlineStartSel, "<mark>", headlineStopSel, "</mark>")
+
+func markHeadline(headline string) string {
+	return headlineMarks.Replace(html.EscapeString(html.UnescapeString(headline)))
+}
+
+var postsSortColumns = map[string]string{
+	consts.SortByCreatedAt: "created_at",
+	consts.SortByUpdatedAt: "updated_at",
+}
+
+func buildPostsQuery(conditions []string, args []any, filter *dto.PostsFilter) (string, []any) {
+	arg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.AuthorId != "" {
+		conditions = append(conditions, "author_id = "+arg(filter.AuthorId))
+	}
+	if filter.FollowerId != "" {
+		conditions = append(conditions, "author_id IN (SELECT author_id FROM follows WHERE follower_id = "+arg(filter.FollowerId)+")")
+	}
+	if filter.Status != "" {
+		conditions = append(conditions, "status = "+arg(filter.Status))
+	}
+	if filter.Tag != "" {
+		conditions = append(conditions, "post_id IN (SELECT post_tags.post_id FROM post_tags JOIN tags USING (tag_id) WHERE tags.slug = "+arg(filter.Tag)+")")
+	}
+	if filter.From != nil {
+		conditions = append(conditions, "created_at >= "+arg(*filter.From))
+	}
+	if filter.To != nil {
+		conditions = append(conditions, "created_at < "+arg(*filter.To))
+	}
+
+	column, ok := postsSortColumns[filter.SortBy]
+	if !ok {
+		column = postsSortColumns[consts.SortByCreatedAt]
+	}
+	direction, comparison := "ASC", ">"
+	if filter.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	if filter.CursorValue != nil {
+		conditions = append(conditions, fmt.Sprintf("(%s, post_id) %s (%s, %s)", column, comparison, arg(*filter.CursorValue), arg(filter.CursorPostId)))
+	}
+
+	query := `SELECT ` + postColumns + ` FROM posts WHERE ` + strings.Join(conditions, " AND ") +
+		fmt.Sprintf(" ORDER BY %s %s, post_id %s", column, direction, direction)
+	if filter.Limit > 0 {
+		query += " LIMIT " + arg(filter.Limit)
+	}
+
+	return query, args
 }
 
-func (r *BlogRepository) GetAllPosts() ([]*entities.Post, error) {
+func (r *BlogRepository) queryPosts(query string, args ...any) ([]*entities.Post, error) {
 	var posts []*entities.Post
 
-	query := `SELECT * FROM posts WHERE status = $1`
-	rows, err := r.DB.Query(query, consts.PublishedState)
+	rows, err := r.DB.Query(query, args...)
 	if err != nil {
 		log.Println(err)
 		return nil, errors.ErrInternalServerError
 	}
+	defer rows.Close()
 
 	for rows.Next() {
 		var post entities.Post
-		err = rows.Scan(&post.PostId, &post.AuthorId, &post.IdempotencyKey, &post.Title, &post.Content, &post.Status, &post.CreatedAt, &post.UpdatedAt)
+		err = scanPost(rows, &post)
 		if err != nil {
 			log.Println(err)
 			return nil, errors.ErrInternalServerError
 		}
-		query = `SELECT * FROM images WHERE post_id = $1`
-		imageRows, err := r.DB.Query(query, post.PostId)
+		posts = append(posts, &post)
+	}
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	if err = r.loadPostsRelations(posts); err != nil {
+		return nil, err
+	}
+
+	return posts, nil
+}
+
+func (r *BlogRepository) loadPostsRelations(posts []*entities.Post) error {
+	if err := r.loadPostsImages(posts); err != nil {
+		return err
+	}
+	if err := r.loadPostsTags(posts); err != nil {
+		return err
+	}
+	if err := r.loadPostsCommentsCount(posts); err != nil {
+		return err
+	}
+	return r.loadPostsReactions(posts)
+}
+
+func (r *BlogRepository) loadPostsImages(posts []*entities.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	postsById := make(map[string]*entities.Post, len(posts))
+	postIds := make([]string, 0, len(posts))
+	for _, post := range posts {
+		postsById[post.PostId] = post
+		postIds = append(postIds, post.PostId)
+	}
+
+	query := `SELECT image_id, post_id, image_url, created_at FROM images WHERE post_id = ANY($1) ORDER BY created_at, image_id`
+	rows, err := r.DB.Query(query, pq.Array(postIds))
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var image entities.Image
+		err = rows.Scan(&image.ImageId, &image.PostId, &image.ImageURL, &image.CreatedAt)
 		if err != nil {
 			log.Println(err)
-			return nil, errors.ErrInternalServerError
+			return errors.ErrInternalServerError
 		}
-		for imageRows.Next() {
-			var image entities.Image
-			err = imageRows.Scan(&image.ImageId, &image.PostId, &image.ImageURL, &image.CreatedAt)
-			if err != nil {
-				log.Println(err)
-				return nil, errors.ErrInternalServerError
-			}
+		if post, ok := postsById[image.PostId]; ok {
 			post.Images = append(post.Images, image)
 		}
-		posts = append(posts, &post)
+	}
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
 	}
 
-	return posts, nil
+	return nil
 }
 
 func (r *BlogRepository) AddImage(postId, imageURL string, createdAt time.Time) (*entities.Image, error) {
 	var image entities.Image
 
-	query := `INSERT INTO images (post_id, image_url, created_at) VALUES ($1, $2, $3) RETURNING *`
+	query := `WITH bumped AS (UPDATE posts SET version = version + 1 WHERE post_id = $1)
+	INSERT INTO images (post_id, image_url, created_at) VALUES ($1, $2, $3) RETURNING *`
 	err := r.DB.QueryRow(query, postId, imageURL, createdAt).Scan(&image.ImageId, &image.PostId, &image.ImageURL, &image.CreatedAt)
 	if err != nil {
 		if stderr.Is(err, sql.ErrNoRows) {
@@ -252,7 +528,8 @@ func (r *BlogRepository) AddImage(postId, imageURL string, createdAt time.Time)
 }
 
 func (r *BlogRepository) SetImageURLById(imageId, URL string) error {
-	query := `UPDATE images SET image_url = $1 WHERE image_id = $2`
+	query := `WITH updated AS (UPDATE images SET image_url = $1 WHERE image_id = $2 RETURNING post_id)
+	UPDATE posts SET version = version + 1 WHERE post_id IN (SELECT post_id FROM updated)`
 	_, err := r.DB.Exec(query, URL, imageId)
 	if err != nil {
 		if stderr.Is(err, sql.ErrNoRows) {
@@ -284,7 +561,8 @@ func (r *BlogRepository) GetImageById(imageId string) (*entities.Image, error) {
 }
 
 func (r *BlogRepository) DeleteImageById(imageId string) error {
-	query := `DELETE FROM images WHERE image_id = $1`
+	query := `WITH deleted AS (DELETE FROM images WHERE image_id = $1 RETURNING post_id)
+	UPDATE posts SET version = version + 1 WHERE post_id IN (SELECT post_id FROM deleted)`
 	_, err := r.DB.Exec(query, imageId)
 	if err != nil {
 		if stderr.Is(err, sql.ErrNoRows) {
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"blog/internal/models/dto"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	stderr "errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+const imagesPerPost = 2
+
+const commentsPerPost = 3
+
+const likesPerPost = 4
+
+var errNotSupported = stderr.New("not supported")
+
+type countingConnector struct {
+	posts   int
+	queries atomic.Int64
+}
+
+func (c *countingConnector) Connect(context.Context) (driver.Conn, error) {
+	return &countingConn{connector: c}, nil
+}
+
+func (c *countingConnector) Driver() driver.Driver {
+	return nil
+}
+
+type countingConn struct {
+	connector *countingConnector
+}
+
+func (c *countingConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errNotSupported
+}
+
+func (c *countingConn) Close() error {
+	return nil
+}
+
+func (c *countingConn) Begin() (driver.Tx, error) {
+	return nil, errNotSupported
+}
+
+func (c *countingConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	c.connector.queries.Add(1)
+
+	now := time.Now()
+	if strings.Contains(query, "FROM post_tags") {
+		values := make([][]driver.Value, 0, c.connector.posts)
+		for i := 0; i < c.connector.posts; i++ {
+			values = append(values, []driver.Value{postIdAt(i), uuid.NewString(), "go", "Go"})
+		}
+		return &fakeRows{columns: []string{"post_id", "tag_id", "slug", "name"}, values: values}, nil
+	}
+	if strings.Contains(query, "FROM post_reactions") {
+		values := make([][]driver.Value, 0, c.connector.posts)
+		for i := 0; i < c.connector.posts; i++ {
+			values = append(values, []driver.Value{postIdAt(i), "like", int64(likesPerPost)})
+		}
+		return &fakeRows{columns: []string{"post_id", "reaction", "count"}, values: values}, nil
+	}
+	if strings.Contains(query, "FROM comments") {
+		values := make([][]driver.Value, 0, c.connector.posts)
+		for i := 0; i < c.connector.posts; i++ {
+			values = append(values, []driver.Value{postIdAt(i), int64(commentsPerPost)})
+		}
+		return &fakeRows{columns: []string{"post_id", "count"}, values: values}, nil
+	}
+	if strings.Contains(query, "FROM images") {
+		values := make([][]driver.Value, 0, c.connector.posts*imagesPerPost)
+		for i := 0; i < c.connector.posts; i++ {
+			for j := 0; j < imagesPerPost; j++ {
+				values = append(values, []driver.Value{uuid.NewString(), postIdAt(i), "url", now})
+			}
+		}
+		return &fakeRows{columns: []string{"image_id", "post_id", "image_url", "created_at"}, values: values}, nil
+	}
+
+	values := make([][]driver.Value, 0, c.connector.posts)
+	for i := 0; i < c.connector.posts; i++ {
+		values = append(values, []driver.Value{postIdAt(i), "author", uuid.NewString(), "title-" + postIdAt(i), "title", "content", "plain", "<p>content</p>", "Published", "simple", int64(1), nil, now, now, nil})
+	}
+	return &fakeRows{columns: strings.Split(postColumns, ", "), values: values}, nil
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func postIdAt(i int) string {
+	return fmt.Sprintf("00000000-0000-0000-0000-%012d", i)
+}
+
+func newCountingRepository(posts int) (*BlogRepository, *countingConnector) {
+	connector := &countingConnector{posts: posts}
+	return NewBlogRepository(sql.OpenDB(connector)), connector
+}
+
+func TestBlogRepository_GetAllPosts_QueryCount(t *testing.T) {
+	for _, postsCount := range []int{0, 1, 10, 100} {
+		t.Run(fmt.Sprintf("posts=%d", postsCount), func(t *testing.T) {
+			repo, connector := newCountingRepository(postsCount)
+
+			posts, err := repo.GetAllPosts(&dto.PostsFilter{})
+			assert.NoError(t, err)
+			assert.Len(t, posts, postsCount)
+			for _, post := range posts {
+				assert.Len(t, post.Images, imagesPerPost)
+				assert.Len(t, post.Tags, 1)
+				assert.Equal(t, commentsPerPost, post.CommentsCount)
+				assert.Equal(t, map[string]int{"like": likesPerPost}, post.Reactions)
+			}
+
+			expectedQueries := int64(5)
+			if postsCount == 0 {
+				expectedQueries = 1
+			}
+			assert.Equal(t, expectedQueries, connector.queries.Load())
+		})
+	}
+}
+
+func TestBlogRepository_GetReadingListPosts_LoadsRelationsOnce(t *testing.T) {
+	repo, connector := newCountingRepository(3)
+
+	posts, err := repo.GetReadingListPosts(uuid.NewString())
+	assert.NoError(t, err)
+	assert.Len(t, posts, 3)
+	for _, post := range posts {
+		assert.Len(t, post.Images, imagesPerPost)
+		assert.Len(t, post.Tags, 1)
+	}
+	assert.Equal(t, int64(5), connector.queries.Load())
+}
+
+func BenchmarkBlogRepository_GetAllPosts(b *testing.B) {
+	for _, postsCount := range []int{10, 100, 1000} {
+		b.Run(fmt.Sprintf("posts=%d", postsCount), func(b *testing.B) {
+			repo, connector := newCountingRepository(postsCount)
+			filter := &dto.PostsFilter{}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := repo.GetAllPosts(filter); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(connector.queries.Load())/float64(b.N), "queries/op")
+		})
+	}
+}
+
+func TestBuildPostsQuery_FollowerId(t *testing.T) {
+	query, args := buildPostsQuery([]string{"status = $1", "deleted_at IS NULL"}, []any{"Published"}, &dto.PostsFilter{
+		FollowerId: "followerId",
+		SortBy:     "created_at",
+		Descending: true,
+		Limit:      11,
+	})
+
+	assert.Contains(t, query, "status = $1 AND deleted_at IS NULL AND author_id IN (SELECT author_id FROM follows WHERE follower_id = $2)")
+	assert.Contains(t, query, "ORDER BY created_at DESC, post_id DESC LIMIT $3")
+	assert.Equal(t, []any{"Published", "followerId", 11}, args)
+}
+
+func TestMarkHeadline(t *testing.T) {
+	headline := "a &lt;script&gt; " + headlineStartSel + "alert" + headlineStopSel + " & <b>"
+
+	assert.Equal(t, "a &lt;script&gt; <mark>alert</mark> &amp; &lt;b&gt;", markHeadline(headline))
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+func (r *BlogRepository) AddBookmark(userId, postId string, createdAt time.Time) error {
+	query := `INSERT INTO bookmarks (user_id, post_id, created_at) VALUES ($1, $2, $3) ON CONFLICT (user_id, post_id) DO NOTHING`
+	_, err := r.DB.Exec(query, userId, postId, createdAt)
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	return nil
+}
+
+func (r *BlogRepository) DeleteBookmark(userId, postId string) error {
+	query := `DELETE FROM bookmarks WHERE user_id = $1 AND post_id = $2`
+	result, err := r.DB.Exec(query, userId, postId)
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	if affected == 0 {
+		return errors.ErrBookmarkNotFound
+	}
+
+	return nil
+}
+
+// GetUserBookmarks returns bookmarked posts that are still published, most
+// recently bookmarked first, starting after the given cursor if it is set.
+func (r *BlogRepository) GetUserBookmarks(userId string, cursorValue *time.Time, cursorPostId string, limit int) ([]*entities.BookmarkedPost, error) {
+	var results []*entities.BookmarkedPost
+
+	args := []any{userId, consts.PublishedState, limit}
+	query := `SELECT ` + postColumns + `, bookmarked.bookmarked_at
+	FROM (SELECT post_id, created_at AS bookmarked_at FROM bookmarks WHERE user_id = $1) AS bookmarked JOIN posts USING (post_id)
+	WHERE posts.status = $2 AND posts.deleted_at IS NULL`
+	if cursorValue != nil {
+		args = append(args, *cursorValue, cursorPostId)
+		query += fmt.Sprintf(" AND (bookmarked.bookmarked_at, post_id) < ($%d, $%d)", len(args)-1, len(args))
+	}
+	query += ` ORDER BY bookmarked.bookmarked_at DESC, post_id DESC LIMIT $3`
+
+	rows, err := r.DB.Query(query, args...)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+	defer rows.Close()
+
+	posts := make([]*entities.Post, 0)
+	for rows.Next() {
+		var result entities.BookmarkedPost
+		err = rows.Scan(append(postFields(&result.Post), &result.BookmarkedAt)...)
+		if err != nil {
+			log.Println(err)
+			return nil, errors.ErrInternalServerError
+		}
+		results = append(results, &result)
+		posts = append(posts, &result.Post)
+	}
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	if err = r.loadPostsRelations(posts); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
@@ -0,0 +1,303 @@
+package repository
+
+import (
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"database/sql"
+	stderr "errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const commentColumns = `comment_id, post_id, author_id, parent_id, content, status, spam_reason, created_at, updated_at, deleted_at`
+
+func scanComment(row rowScanner, comment *entities.Comment) error {
+	return row.Scan(&comment.CommentId, &comment.PostId, &comment.AuthorId, &comment.ParentId, &comment.Content, &comment.Status, &comment.SpamReason, &comment.CreatedAt, &comment.UpdatedAt, &comment.DeletedAt)
+}
+
+func (r *BlogRepository) CreateComment(postId, authorId string, parentId *string, content, status, spamReason string, createdAt time.Time) (*entities.Comment, error) {
+	var comment entities.Comment
+
+	query := `INSERT INTO comments (post_id, author_id, parent_id, content, status, spam_reason, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $7) RETURNING ` + commentColumns
+	err := scanComment(r.DB.QueryRow(query, postId, authorId, parentId, content, status, spamReason, createdAt), &comment)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return &comment, nil
+}
+
+func (r *BlogRepository) GetCommentById(commentId string) (*entities.Comment, error) {
+	var comment entities.Comment
+
+	query := `SELECT ` + commentColumns + ` FROM comments WHERE comment_id = $1`
+	err := scanComment(r.DB.QueryRow(query, commentId), &comment)
+	if err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrCommentNotFound
+		}
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return &comment, nil
+}
+
+func (r *BlogRepository) EditComment(commentId, content, status, spamReason string, updatedAt time.Time) (*entities.Comment, error) {
+	var comment entities.Comment
+
+	query := `UPDATE comments SET content = $1, status = $2, spam_reason = $3, updated_at = $4 WHERE comment_id = $5 AND deleted_at IS NULL RETURNING ` + commentColumns
+	err := scanComment(r.DB.QueryRow(query, content, status, spamReason, updatedAt, commentId), &comment)
+	if err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrCommentNotFound
+		}
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return &comment, nil
+}
+
+// DeleteComment removes the comment. A comment that still has replies is only
+// marked as deleted and its content is wiped, so that the replies stay in the
+// thread; such placeholders are removed together with their last reply.
+func (r *BlogRepository) DeleteComment(commentId string, deletedAt time.Time) error {
+	tx, err := r.DB.Begin()
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	defer tx.Rollback()
+
+	var parentId *string
+	query := `SELECT parent_id FROM comments WHERE comment_id = $1 AND deleted_at IS NULL FOR UPDATE`
+	if err = tx.QueryRow(query, commentId).Scan(&parentId); err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return errors.ErrCommentNotFound
+		}
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	var hasReplies bool
+	query = `SELECT EXISTS (SELECT 1 FROM comments WHERE parent_id = $1)`
+	if err = tx.QueryRow(query, commentId).Scan(&hasReplies); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	if hasReplies {
+		query = `UPDATE comments SET content = '', deleted_at = $2 WHERE comment_id = $1`
+		if _, err = tx.Exec(query, commentId, deletedAt); err != nil {
+			log.Println(err)
+			return errors.ErrInternalServerError
+		}
+	} else {
+		query = `DELETE FROM comments WHERE comment_id = $1`
+		if _, err = tx.Exec(query, commentId); err != nil {
+			log.Println(err)
+			return errors.ErrInternalServerError
+		}
+
+		query = `DELETE FROM comments WHERE comment_id = $1 AND deleted_at IS NOT NULL
+		AND NOT EXISTS (SELECT 1 FROM comments WHERE parent_id = $1)
+		RETURNING parent_id`
+		for parentId != nil {
+			err = tx.QueryRow(query, *parentId).Scan(&parentId)
+			if stderr.Is(err, sql.ErrNoRows) {
+				break
+			}
+			if err != nil {
+				log.Println(err)
+				return errors.ErrInternalServerError
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	return nil
+}
+
+// GetPostComments returns a page of approved top-level comments of the post
+// in chronological order, starting after the given cursor if it is set.
+func (r *BlogRepository) GetPostComments(postId string, cursorValue *time.Time, cursorCommentId string, limit int) ([]*entities.Comment, error) {
+	args := []any{postId, limit, consts.ApprovedCommentState}
+	query := `SELECT ` + commentColumns + ` FROM comments WHERE post_id = $1 AND parent_id IS NULL AND status = $3`
+	if cursorValue != nil {
+		args = append(args, *cursorValue, cursorCommentId)
+		query += fmt.Sprintf(" AND (created_at, comment_id) > ($%d, $%d)", len(args)-1, len(args))
+	}
+	query += ` ORDER BY created_at, comment_id LIMIT $2`
+
+	return r.queryComments(query, args...)
+}
+
+// GetCommentsReplies returns up to limit approved replies below each of the
+// given comments, at any depth, in chronological order. Replies to hidden
+// comments are skipped.
+func (r *BlogRepository) GetCommentsReplies(commentIds []string, limit int) ([]*entities.Comment, error) {
+	if len(commentIds) == 0 {
+		return nil, nil
+	}
+
+	query := `WITH RECURSIVE replies AS (
+		SELECT ` + commentColumns + `, parent_id AS thread_id FROM comments WHERE parent_id = ANY($1) AND status = $2
+		UNION ALL
+		SELECT c.comment_id, c.post_id, c.author_id, c.parent_id, c.content, c.status, c.spam_reason, c.created_at, c.updated_at, c.deleted_at, replies.thread_id
+		FROM comments c JOIN replies ON c.parent_id = replies.comment_id
+		WHERE c.status = $2
+	)
+	SELECT ` + commentColumns + ` FROM (
+		SELECT *, ROW_NUMBER() OVER (PARTITION BY thread_id ORDER BY created_at, comment_id) AS position FROM replies
+	) ranked
+	WHERE position <= $3
+	ORDER BY created_at, comment_id`
+
+	return r.queryComments(query, pq.Array(commentIds), consts.ApprovedCommentState, limit)
+}
+
+// GetCommentReplies returns a page of approved replies below the comment, at
+// any depth, in chronological order, starting after the given cursor if it is set.
+func (r *BlogRepository) GetCommentReplies(commentId string, cursorValue *time.Time, cursorCommentId string, limit int) ([]*entities.Comment, error) {
+	args := []any{commentId, consts.ApprovedCommentState, limit}
+	query := `WITH RECURSIVE replies AS (
+		SELECT ` + commentColumns + ` FROM comments WHERE parent_id = $1 AND status = $2
+		UNION ALL
+		SELECT c.comment_id, c.post_id, c.author_id, c.parent_id, c.content, c.status, c.spam_reason, c.created_at, c.updated_at, c.deleted_at
+		FROM comments c JOIN replies ON c.parent_id = replies.comment_id
+		WHERE c.status = $2
+	)
+	SELECT ` + commentColumns + ` FROM replies`
+	if cursorValue != nil {
+		args = append(args, *cursorValue, cursorCommentId)
+		query += fmt.Sprintf(" WHERE (created_at, comment_id) > ($%d, $%d)", len(args)-1, len(args))
+	}
+	query += ` ORDER BY created_at, comment_id LIMIT $3`
+
+	return r.queryComments(query, args...)
+}
+
+func (r *BlogRepository) CountDuplicateComments(authorId, commentId, content string, since time.Time) (int, error) {
+	var count int
+
+	query := `SELECT COUNT(*) FROM comments WHERE author_id = $1 AND content = $2 AND created_at >= $3 AND comment_id::text <> $4`
+	err := r.DB.QueryRow(query, authorId, content, since, commentId).Scan(&count)
+	if err != nil {
+		log.Println(err)
+		return 0, errors.ErrInternalServerError
+	}
+
+	return count, nil
+}
+
+// GetModerationQueue returns comments with the given status left on active
+// posts of the author, oldest first, starting after the given cursor if it is set.
+func (r *BlogRepository) GetModerationQueue(postAuthorId, status string, cursorValue *time.Time, cursorCommentId string, limit int) ([]*entities.Comment, error) {
+	args := []any{postAuthorId, status, limit}
+	query := `SELECT c.comment_id, c.post_id, c.author_id, c.parent_id, c.content, c.status, c.spam_reason, c.created_at, c.updated_at, c.deleted_at
+	FROM comments c JOIN posts p ON p.post_id = c.post_id
+	WHERE p.author_id = $1 AND p.deleted_at IS NULL AND c.status = $2 AND c.deleted_at IS NULL`
+	if cursorValue != nil {
+		args = append(args, *cursorValue, cursorCommentId)
+		query += fmt.Sprintf(" AND (c.created_at, c.comment_id) > ($%d, $%d)", len(args)-1, len(args))
+	}
+	query += ` ORDER BY c.created_at, c.comment_id LIMIT $3`
+
+	return r.queryComments(query, args...)
+}
+
+// SetCommentsStatus moderates the given comments left on posts of the author
+// and reports how many of them were updated.
+func (r *BlogRepository) SetCommentsStatus(postAuthorId string, commentIds []string, status string) (int, error) {
+	query := `UPDATE comments c SET status = $1, spam_reason = CASE WHEN $1 = $4 THEN '' ELSE c.spam_reason END
+	FROM posts p
+	WHERE p.post_id = c.post_id AND p.author_id = $2 AND p.deleted_at IS NULL AND c.deleted_at IS NULL AND c.comment_id::text = ANY($3)`
+	result, err := r.DB.Exec(query, status, postAuthorId, pq.Array(commentIds), consts.ApprovedCommentState)
+	if err != nil {
+		log.Println(err)
+		return 0, errors.ErrInternalServerError
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return 0, errors.ErrInternalServerError
+	}
+
+	return int(affected), nil
+}
+
+func (r *BlogRepository) queryComments(query string, args ...any) ([]*entities.Comment, error) {
+	var comments []*entities.Comment
+
+	rows, err := r.DB.Query(query, args...)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var comment entities.Comment
+		if err = scanComment(rows, &comment); err != nil {
+			log.Println(err)
+			return nil, errors.ErrInternalServerError
+		}
+		comments = append(comments, &comment)
+	}
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return comments, nil
+}
+
+func (r *BlogRepository) loadPostsCommentsCount(posts []*entities.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	postsById := make(map[string]*entities.Post, len(posts))
+	postIds := make([]string, 0, len(posts))
+	for _, post := range posts {
+		postsById[post.PostId] = post
+		postIds = append(postIds, post.PostId)
+	}
+
+	query := `SELECT post_id, COUNT(*) FROM comments WHERE post_id = ANY($1) AND status = $2 AND deleted_at IS NULL GROUP BY post_id`
+	rows, err := r.DB.Query(query, pq.Array(postIds), consts.ApprovedCommentState)
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var postId string
+		var count int
+		if err = rows.Scan(&postId, &count); err != nil {
+			log.Println(err)
+			return errors.ErrInternalServerError
+		}
+		if post, ok := postsById[postId]; ok {
+			post.CommentsCount = count
+		}
+	}
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	return nil
+}
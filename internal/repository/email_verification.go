@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"blog/pkg/consts/errors"
+	"database/sql"
+	stderr "errors"
+	"log"
+	"time"
+)
+
+// CreateVerificationToken stores a new email verification token of the user
+// and invalidates the ones sent before it.
+func (r *BlogRepository) CreateVerificationToken(userId, tokenHash string, createdAt, expiresAt time.Time) error {
+	tx, err := r.DB.Begin()
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE email_verification_tokens SET used_at = $1 WHERE user_id = $2 AND used_at IS NULL`
+	if _, err = tx.Exec(query, createdAt, userId); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	query = `INSERT INTO email_verification_tokens (token_hash, user_id, created_at, expires_at) VALUES ($1, $2, $3, $4)`
+	if _, err = tx.Exec(query, tokenHash, userId, createdAt, expiresAt); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	return nil
+}
+
+func (r *BlogRepository) GetLastVerificationTokenTime(userId string) (*time.Time, error) {
+	var createdAt *time.Time
+
+	query := `SELECT MAX(created_at) FROM email_verification_tokens WHERE user_id = $1`
+	if err := r.DB.QueryRow(query, userId).Scan(&createdAt); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return createdAt, nil
+}
+
+// VerifyEmail spends the verification token and marks the email of its user
+// as verified.
+func (r *BlogRepository) VerifyEmail(tokenHash string, verifiedAt time.Time) error {
+	var userId string
+	var expiresAt time.Time
+	var usedAt *time.Time
+
+	tx, err := r.DB.Begin()
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	defer tx.Rollback()
+
+	query := `SELECT user_id, expires_at, used_at FROM email_verification_tokens WHERE token_hash = $1 FOR UPDATE`
+	err = tx.QueryRow(query, tokenHash).Scan(&userId, &expiresAt, &usedAt)
+	if err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return errors.ErrInvalidVerificationToken
+		}
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	if usedAt != nil || !expiresAt.After(verifiedAt) {
+		return errors.ErrInvalidVerificationToken
+	}
+
+	query = `UPDATE email_verification_tokens SET used_at = $1 WHERE token_hash = $2`
+	if _, err = tx.Exec(query, verifiedAt, tokenHash); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	query = `UPDATE users SET email_verified_at = COALESCE(email_verified_at, $1) WHERE user_id = $2`
+	if _, err = tx.Exec(query, verifiedAt, userId); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	return nil
+}
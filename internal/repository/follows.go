@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"blog/internal/models/entities"
+	"blog/pkg/consts/errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+func (r *BlogRepository) Follow(followerId, authorId string, createdAt time.Time) error {
+	query := `INSERT INTO follows (follower_id, author_id, created_at) VALUES ($1, $2, $3) ON CONFLICT (follower_id, author_id) DO NOTHING`
+	_, err := r.DB.Exec(query, followerId, authorId, createdAt)
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	return nil
+}
+
+func (r *BlogRepository) Unfollow(followerId, authorId string) error {
+	query := `DELETE FROM follows WHERE follower_id = $1 AND author_id = $2`
+	result, err := r.DB.Exec(query, followerId, authorId)
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	if affected == 0 {
+		return errors.ErrFollowNotFound
+	}
+
+	return nil
+}
+
+// GetFollowers returns the users following the author, most recent first.
+func (r *BlogRepository) GetFollowers(authorId string, cursorValue *time.Time, cursorUserId string, limit int) ([]*entities.Follow, error) {
+	return r.queryFollows("follower_id", "author_id", authorId, cursorValue, cursorUserId, limit)
+}
+
+// GetFollowing returns the authors the user follows, most recent first.
+func (r *BlogRepository) GetFollowing(userId string, cursorValue *time.Time, cursorUserId string, limit int) ([]*entities.Follow, error) {
+	return r.queryFollows("author_id", "follower_id", userId, cursorValue, cursorUserId, limit)
+}
+
+func (r *BlogRepository) queryFollows(column, byColumn, userId string, cursorValue *time.Time, cursorUserId string, limit int) ([]*entities.Follow, error) {
+	var follows []*entities.Follow
+
+	args := []any{userId, limit}
+	query := fmt.Sprintf(`SELECT %s, created_at FROM follows WHERE %s = $1`, column, byColumn)
+	if cursorValue != nil {
+		args = append(args, *cursorValue, cursorUserId)
+		query += fmt.Sprintf(" AND (created_at, %s) < ($3, $4)", column)
+	}
+	query += fmt.Sprintf(" ORDER BY created_at DESC, %s DESC LIMIT $2", column)
+
+	rows, err := r.DB.Query(query, args...)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var follow entities.Follow
+		if err = rows.Scan(&follow.UserId, &follow.FollowedAt); err != nil {
+			log.Println(err)
+			return nil, errors.ErrInternalServerError
+		}
+		follows = append(follows, &follow)
+	}
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return follows, nil
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"blog/pkg/consts/errors"
+	"database/sql"
+	stderr "errors"
+	"log"
+	"time"
+)
+
+// CreatePasswordResetToken stores a new reset token of the user and
+// invalidates the ones requested before it.
+func (r *BlogRepository) CreatePasswordResetToken(userId, tokenHash string, createdAt, expiresAt time.Time) error {
+	tx, err := r.DB.Begin()
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE password_reset_tokens SET used_at = $1 WHERE user_id = $2 AND used_at IS NULL`
+	if _, err = tx.Exec(query, createdAt, userId); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	query = `INSERT INTO password_reset_tokens (token_hash, user_id, created_at, expires_at) VALUES ($1, $2, $3, $4)`
+	if _, err = tx.Exec(query, tokenHash, userId, createdAt, expiresAt); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	return nil
+}
+
+func (r *BlogRepository) GetLastPasswordResetTokenTime(userId string) (*time.Time, error) {
+	var createdAt *time.Time
+
+	query := `SELECT MAX(created_at) FROM password_reset_tokens WHERE user_id = $1`
+	if err := r.DB.QueryRow(query, userId).Scan(&createdAt); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return createdAt, nil
+}
+
+// ResetPassword spends the reset token, sets the new password and revokes
+// every session of the user.
+func (r *BlogRepository) ResetPassword(tokenHash, passwordHash string, resetAt time.Time) error {
+	var userId string
+	var expiresAt time.Time
+	var usedAt *time.Time
+
+	tx, err := r.DB.Begin()
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	defer tx.Rollback()
+
+	query := `SELECT user_id, expires_at, used_at FROM password_reset_tokens WHERE token_hash = $1 FOR UPDATE`
+	err = tx.QueryRow(query, tokenHash).Scan(&userId, &expiresAt, &usedAt)
+	if err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return errors.ErrInvalidResetToken
+		}
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	if usedAt != nil || !expiresAt.After(resetAt) {
+		return errors.ErrInvalidResetToken
+	}
+
+	query = `UPDATE password_reset_tokens SET used_at = $1 WHERE token_hash = $2`
+	if _, err = tx.Exec(query, resetAt, tokenHash); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	query = `UPDATE users SET password_hash = $1, tokens_valid_after = $2 WHERE user_id = $3`
+	if _, err = tx.Exec(query, passwordHash, resetAt, userId); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	query = `UPDATE sessions SET revoked_at = $1 WHERE user_id = $2 AND revoked_at IS NULL`
+	if _, err = tx.Exec(query, resetAt, userId); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// ToggleReaction removes the reaction of the user if it is set and adds it
+// otherwise, reporting whether the reaction is set afterwards. When a
+// concurrent toggle adds the same reaction first, the insert hits the conflict
+// and the reaction is reported as set, since it is.
+func (r *BlogRepository) ToggleReaction(postId, userId, reaction string, createdAt time.Time) (bool, error) {
+	var reacted bool
+
+	query := `WITH deleted AS (
+		DELETE FROM post_reactions WHERE post_id = $1 AND user_id = $2 AND reaction = $3 RETURNING post_id
+	), inserted AS (
+		INSERT INTO post_reactions (post_id, user_id, reaction, created_at)
+		SELECT $1, $2, $3, $4 WHERE NOT EXISTS (SELECT 1 FROM deleted)
+		ON CONFLICT DO NOTHING
+	)
+	SELECT NOT EXISTS (SELECT 1 FROM deleted)`
+	err := r.DB.QueryRow(query, postId, userId, reaction, createdAt).Scan(&reacted)
+	if err != nil {
+		log.Println(err)
+		return false, errors.ErrInternalServerError
+	}
+
+	return reacted, nil
+}
+
+func (r *BlogRepository) GetPostReactions(postId string) (map[string]int, error) {
+	post := &entities.Post{PostId: postId}
+	if err := r.loadPostsReactions([]*entities.Post{post}); err != nil {
+		return nil, err
+	}
+	return post.Reactions, nil
+}
+
+// GetUserReactedPosts returns published posts the user reacted to, most
+// recently reacted first, starting after the given cursor if it is set.
+func (r *BlogRepository) GetUserReactedPosts(userId string, cursorValue *time.Time, cursorPostId string, limit int) ([]*entities.ReactedPost, error) {
+	var results []*entities.ReactedPost
+
+	args := []any{userId, consts.PublishedState, limit}
+	query := `SELECT ` + postColumns + `, reacted.reactions, reacted.reacted_at
+	FROM (
+		SELECT post_id, array_agg(reaction ORDER BY created_at) AS reactions, MAX(created_at) AS reacted_at
+		FROM post_reactions WHERE user_id = $1 GROUP BY post_id
+	) AS reacted JOIN posts USING (post_id)
+	WHERE posts.status = $2 AND posts.deleted_at IS NULL`
+	if cursorValue != nil {
+		args = append(args, *cursorValue, cursorPostId)
+		query += fmt.Sprintf(" AND (reacted.reacted_at, post_id) < ($%d, $%d)", len(args)-1, len(args))
+	}
+	query += ` ORDER BY reacted.reacted_at DESC, post_id DESC LIMIT $3`
+
+	rows, err := r.DB.Query(query, args...)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+	defer rows.Close()
+
+	posts := make([]*entities.Post, 0)
+	for rows.Next() {
+		var result entities.ReactedPost
+		err = rows.Scan(append(postFields(&result.Post), pq.Array(&result.Reactions), &result.ReactedAt)...)
+		if err != nil {
+			log.Println(err)
+			return nil, errors.ErrInternalServerError
+		}
+		results = append(results, &result)
+		posts = append(posts, &result.Post)
+	}
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	if err = r.loadPostsRelations(posts); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *BlogRepository) loadPostsReactions(posts []*entities.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	postsById := make(map[string]*entities.Post, len(posts))
+	postIds := make([]string, 0, len(posts))
+	for _, post := range posts {
+		post.Reactions = make(map[string]int)
+		postsById[post.PostId] = post
+		postIds = append(postIds, post.PostId)
+	}
+
+	query := `SELECT post_id, reaction, COUNT(*) FROM post_reactions WHERE post_id = ANY($1) GROUP BY post_id, reaction`
+	rows, err := r.DB.Query(query, pq.Array(postIds))
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var postId, reaction string
+		var count int
+		if err = rows.Scan(&postId, &reaction, &count); err != nil {
+			log.Println(err)
+			return errors.ErrInternalServerError
+		}
+		if post, ok := postsById[postId]; ok {
+			post.Reactions[reaction] = count
+		}
+	}
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	return nil
+}
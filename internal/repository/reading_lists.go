@@ -0,0 +1,251 @@
+package repository
+
+import (
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"database/sql"
+	stderr "errors"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const readingListsNameConstraint = "reading_lists_user_id_name_key"
+
+// readingListColumns counts only posts that are still published, so hidden
+// posts keep their place in the list without showing up in it.
+const readingListColumns = `list_id, user_id, name,
+	(SELECT COUNT(*) FROM reading_list_posts JOIN posts USING (post_id) WHERE reading_list_posts.list_id = reading_lists.list_id AND posts.status = $2 AND posts.deleted_at IS NULL),
+	created_at, updated_at`
+
+func scanReadingList(row rowScanner, list *entities.ReadingList) error {
+	return row.Scan(&list.ListId, &list.UserId, &list.Name, &list.PostsCount, &list.CreatedAt, &list.UpdatedAt)
+}
+
+func (r *BlogRepository) CreateReadingList(userId, name string, createdAt time.Time) (*entities.ReadingList, error) {
+	var list entities.ReadingList
+
+	query := `INSERT INTO reading_lists (user_id, name, created_at, updated_at) VALUES ($1, $2, $3, $3) RETURNING list_id, user_id, name, 0, created_at, updated_at`
+	err := scanReadingList(r.DB.QueryRow(query, userId, name, createdAt), &list)
+	if err != nil {
+		pgErr, ok := err.(*pq.Error)
+		if ok && pgErr.Code == "23505" && pgErr.Constraint == readingListsNameConstraint {
+			return nil, errors.ErrReadingListAlreadyExists
+		}
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return &list, nil
+}
+
+func (r *BlogRepository) GetReadingLists(userId string) ([]*entities.ReadingList, error) {
+	var lists []*entities.ReadingList
+
+	query := `SELECT ` + readingListColumns + ` FROM reading_lists WHERE user_id = $1 ORDER BY name, list_id`
+	rows, err := r.DB.Query(query, userId, consts.PublishedState)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var list entities.ReadingList
+		if err = scanReadingList(rows, &list); err != nil {
+			log.Println(err)
+			return nil, errors.ErrInternalServerError
+		}
+		lists = append(lists, &list)
+	}
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return lists, nil
+}
+
+func (r *BlogRepository) GetReadingListById(listId string) (*entities.ReadingList, error) {
+	var list entities.ReadingList
+
+	query := `SELECT ` + readingListColumns + ` FROM reading_lists WHERE list_id = $1`
+	err := scanReadingList(r.DB.QueryRow(query, listId, consts.PublishedState), &list)
+	if err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrReadingListNotFound
+		}
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return &list, nil
+}
+
+func (r *BlogRepository) RenameReadingList(listId, name string, updatedAt time.Time) error {
+	query := `UPDATE reading_lists SET name = $1, updated_at = $2 WHERE list_id = $3`
+	result, err := r.DB.Exec(query, name, updatedAt, listId)
+	if err != nil {
+		pgErr, ok := err.(*pq.Error)
+		if ok && pgErr.Code == "23505" && pgErr.Constraint == readingListsNameConstraint {
+			return errors.ErrReadingListAlreadyExists
+		}
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	if affected == 0 {
+		return errors.ErrReadingListNotFound
+	}
+
+	return nil
+}
+
+func (r *BlogRepository) DeleteReadingList(listId string) error {
+	query := `DELETE FROM reading_lists WHERE list_id = $1`
+	result, err := r.DB.Exec(query, listId)
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	if affected == 0 {
+		return errors.ErrReadingListNotFound
+	}
+
+	return nil
+}
+
+// GetReadingListPosts returns the published posts of the list in list order.
+func (r *BlogRepository) GetReadingListPosts(listId string) ([]*entities.Post, error) {
+	query := `SELECT ` + postColumns + `
+	FROM reading_list_posts JOIN posts USING (post_id)
+	WHERE reading_list_posts.list_id = $1 AND posts.status = $2 AND posts.deleted_at IS NULL
+	ORDER BY reading_list_posts.position`
+	return r.queryPosts(query, listId, consts.PublishedState)
+}
+
+// AddPostToReadingList puts the post at the 1-based position of the list,
+// moving it there if it is already in the list. Positions count only posts
+// that are still published, as the list is shown; the post goes right before
+// the published post now at that position. A missing or too large position
+// appends the post to the end.
+func (r *BlogRepository) AddPostToReadingList(listId, postId string, position *int, addedAt time.Time) error {
+	tx, err := r.DB.Begin()
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE reading_lists SET updated_at = $1 WHERE list_id = $2`
+	if _, err = tx.Exec(query, addedAt, listId); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	var previousPosition int
+	query = `DELETE FROM reading_list_posts WHERE list_id = $1 AND post_id = $2 RETURNING position, added_at`
+	err = tx.QueryRow(query, listId, postId).Scan(&previousPosition, &addedAt)
+	if err != nil && !stderr.Is(err, sql.ErrNoRows) {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	if err == nil {
+		query = `UPDATE reading_list_posts SET position = position - 1 WHERE list_id = $1 AND position > $2`
+		if _, err = tx.Exec(query, listId, previousPosition); err != nil {
+			log.Println(err)
+			return errors.ErrInternalServerError
+		}
+	}
+
+	var target int
+	if position != nil {
+		query = `SELECT reading_list_posts.position
+		FROM reading_list_posts JOIN posts USING (post_id)
+		WHERE reading_list_posts.list_id = $1 AND posts.status = $2 AND posts.deleted_at IS NULL
+		ORDER BY reading_list_posts.position OFFSET $3 LIMIT 1`
+		err = tx.QueryRow(query, listId, consts.PublishedState, *position-1).Scan(&target)
+		if err != nil && !stderr.Is(err, sql.ErrNoRows) {
+			log.Println(err)
+			return errors.ErrInternalServerError
+		}
+	}
+	if target == 0 {
+		query = `SELECT COUNT(*) + 1 FROM reading_list_posts WHERE list_id = $1`
+		if err = tx.QueryRow(query, listId).Scan(&target); err != nil {
+			log.Println(err)
+			return errors.ErrInternalServerError
+		}
+	}
+
+	query = `UPDATE reading_list_posts SET position = position + 1 WHERE list_id = $1 AND position >= $2`
+	if _, err = tx.Exec(query, listId, target); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	query = `INSERT INTO reading_list_posts (list_id, post_id, position, added_at) VALUES ($1, $2, $3, $4)`
+	if _, err = tx.Exec(query, listId, postId, target, addedAt); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	return nil
+}
+
+func (r *BlogRepository) RemovePostFromReadingList(listId, postId string, updatedAt time.Time) error {
+	tx, err := r.DB.Begin()
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	defer tx.Rollback()
+
+	var position int
+	query := `DELETE FROM reading_list_posts WHERE list_id = $1 AND post_id = $2 RETURNING position`
+	err = tx.QueryRow(query, listId, postId).Scan(&position)
+	if err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return errors.ErrPostNotFound
+		}
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	query = `UPDATE reading_list_posts SET position = position - 1 WHERE list_id = $1 AND position > $2`
+	if _, err = tx.Exec(query, listId, position); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	query = `UPDATE reading_lists SET updated_at = $1 WHERE list_id = $2`
+	if _, err = tx.Exec(query, updatedAt, listId); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"blog/internal/models/entities"
+	"blog/pkg/consts/errors"
+	"database/sql"
+	stderr "errors"
+	"log"
+	"time"
+)
+
+func insertPostRevision(tx *sql.Tx, postId, authorId, title, content, contentFormat string, createdAt time.Time) (*entities.Revision, error) {
+	var revision entities.Revision
+
+	query := `INSERT INTO post_revisions (post_id, author_id, title, content, content_format, created_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING revision_id, post_id, author_id, title, content, content_format, created_at`
+	err := tx.QueryRow(query, postId, authorId, title, content, contentFormat, createdAt).Scan(&revision.RevisionId, &revision.PostId, &revision.AuthorId, &revision.Title, &revision.Content, &revision.ContentFormat, &revision.CreatedAt)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return &revision, nil
+}
+
+func (r *BlogRepository) GetPostRevisions(postId string) ([]*entities.Revision, error) {
+	var revisions []*entities.Revision
+
+	query := `SELECT revision_id, post_id, author_id, title, content, content_format, created_at FROM post_revisions WHERE post_id = $1 ORDER BY created_at DESC, revision_id DESC`
+	rows, err := r.DB.Query(query, postId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var revision entities.Revision
+		err = rows.Scan(&revision.RevisionId, &revision.PostId, &revision.AuthorId, &revision.Title, &revision.Content, &revision.ContentFormat, &revision.CreatedAt)
+		if err != nil {
+			log.Println(err)
+			return nil, errors.ErrInternalServerError
+		}
+		revisions = append(revisions, &revision)
+	}
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return revisions, nil
+}
+
+func (r *BlogRepository) GetPostRevisionById(revisionId string) (*entities.Revision, error) {
+	var revision entities.Revision
+
+	query := `SELECT revision_id, post_id, author_id, title, content, content_format, created_at FROM post_revisions WHERE revision_id = $1`
+	err := r.DB.QueryRow(query, revisionId).Scan(&revision.RevisionId, &revision.PostId, &revision.AuthorId, &revision.Title, &revision.Content, &revision.ContentFormat, &revision.CreatedAt)
+	if err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrRevisionNotFound
+		}
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return &revision, nil
+}
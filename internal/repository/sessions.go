@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"blog/internal/models/entities"
+	"blog/pkg/consts/errors"
+	"database/sql"
+	stderr "errors"
+	"log"
+	"time"
+)
+
+const sessionColumns = `session_id, user_id, device, user_agent, ip, created_at, last_used_at, expires_at, revoked_at`
+
+func scanSession(row rowScanner, session *entities.Session) error {
+	return row.Scan(&session.SessionId, &session.UserId, &session.Device, &session.UserAgent, &session.IP, &session.CreatedAt, &session.LastUsedAt, &session.ExpiresAt, &session.RevokedAt)
+}
+
+func (r *BlogRepository) CreateSession(sessionId, userId, tokenHash, device, userAgent, ip string, createdAt, expiresAt time.Time) error {
+	tx, err := r.DB.Begin()
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO sessions (session_id, user_id, device, user_agent, ip, created_at, last_used_at, expires_at) VALUES ($1, $2, $3, $4, $5, $6, $6, $7)`
+	if _, err = tx.Exec(query, sessionId, userId, device, userAgent, ip, createdAt, expiresAt); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	query = `INSERT INTO session_tokens (token_hash, session_id, created_at) VALUES ($1, $2, $3)`
+	if _, err = tx.Exec(query, tokenHash, sessionId, createdAt); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	return nil
+}
+
+// RotateSessionToken replaces the refresh token of the session with a new
+// one. Presenting a token that was already rotated means it leaked, so the
+// whole session is revoked and the refresh is refused.
+func (r *BlogRepository) RotateSessionToken(sessionId, tokenHash, newTokenHash string, usedAt, expiresAt time.Time) (*entities.Session, error) {
+	var session entities.Session
+	var rotatedAt *time.Time
+
+	tx, err := r.DB.Begin()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+	defer tx.Rollback()
+
+	query := `SELECT session_tokens.rotated_at FROM session_tokens WHERE token_hash = $1 AND session_id = $2 FOR UPDATE`
+	err = tx.QueryRow(query, tokenHash, sessionId).Scan(&rotatedAt)
+	if err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrInvalidRefreshToken
+		}
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	query = `SELECT ` + sessionColumns + ` FROM sessions WHERE session_id = $1 FOR UPDATE`
+	if err = scanSession(tx.QueryRow(query, sessionId), &session); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+	if session.RevokedAt != nil || !session.ExpiresAt.After(usedAt) {
+		return nil, errors.ErrInvalidRefreshToken
+	}
+
+	if rotatedAt != nil {
+		query = `UPDATE sessions SET revoked_at = $1 WHERE session_id = $2`
+		if _, err = tx.Exec(query, usedAt, sessionId); err != nil {
+			log.Println(err)
+			return nil, errors.ErrInternalServerError
+		}
+		if err = tx.Commit(); err != nil {
+			log.Println(err)
+			return nil, errors.ErrInternalServerError
+		}
+		return nil, errors.ErrInvalidRefreshToken
+	}
+
+	query = `UPDATE session_tokens SET rotated_at = $1 WHERE token_hash = $2`
+	if _, err = tx.Exec(query, usedAt, tokenHash); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	query = `INSERT INTO session_tokens (token_hash, session_id, created_at) VALUES ($1, $2, $3)`
+	if _, err = tx.Exec(query, newTokenHash, sessionId, usedAt); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	query = `UPDATE sessions SET last_used_at = $1, expires_at = $2 WHERE session_id = $3`
+	if _, err = tx.Exec(query, usedAt, expiresAt, sessionId); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+	session.LastUsedAt, session.ExpiresAt = usedAt, expiresAt
+
+	if err = tx.Commit(); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return &session, nil
+}
+
+// GetActiveSessionByToken returns the session the refresh token is current
+// for, if the session is neither revoked nor expired.
+func (r *BlogRepository) GetActiveSessionByToken(sessionId, tokenHash string, now time.Time) (*entities.Session, error) {
+	var session entities.Session
+
+	query := `SELECT ` + sessionColumns + ` FROM sessions
+	WHERE session_id = $1 AND revoked_at IS NULL AND expires_at > $3
+	AND EXISTS (SELECT 1 FROM session_tokens WHERE session_tokens.session_id = sessions.session_id AND token_hash = $2 AND rotated_at IS NULL)`
+	err := scanSession(r.DB.QueryRow(query, sessionId, tokenHash, now), &session)
+	if err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrInvalidRefreshToken
+		}
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return &session, nil
+}
+
+func (r *BlogRepository) GetSessionById(sessionId string) (*entities.Session, error) {
+	var session entities.Session
+
+	query := `SELECT ` + sessionColumns + ` FROM sessions WHERE session_id = $1`
+	err := scanSession(r.DB.QueryRow(query, sessionId), &session)
+	if err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrSessionNotFound
+		}
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return &session, nil
+}
+
+func (r *BlogRepository) GetUserSessions(userId string, now time.Time) ([]*entities.Session, error) {
+	var sessions []*entities.Session
+
+	query := `SELECT ` + sessionColumns + ` FROM sessions WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > $2 ORDER BY last_used_at DESC, session_id`
+	rows, err := r.DB.Query(query, userId, now)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var session entities.Session
+		if err = scanSession(rows, &session); err != nil {
+			log.Println(err)
+			return nil, errors.ErrInternalServerError
+		}
+		sessions = append(sessions, &session)
+	}
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return sessions, nil
+}
+
+func (r *BlogRepository) RevokeSession(userId, sessionId string, revokedAt time.Time) error {
+	query := `UPDATE sessions SET revoked_at = $1 WHERE session_id = $2 AND user_id = $3 AND revoked_at IS NULL`
+	result, err := r.DB.Exec(query, revokedAt, sessionId, userId)
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	if affected == 0 {
+		return errors.ErrSessionNotFound
+	}
+
+	return nil
+}
+
+// RevokeAllTokens revokes every session of the user and every access token
+// issued before validAfter.
+func (r *BlogRepository) RevokeAllTokens(userId string, validAfter time.Time) error {
+	tx, err := r.DB.Begin()
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE sessions SET revoked_at = $1 WHERE user_id = $2 AND revoked_at IS NULL`
+	if _, err = tx.Exec(query, validAfter, userId); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	query = `UPDATE users SET tokens_valid_after = $1 WHERE user_id = $2`
+	if _, err = tx.Exec(query, validAfter, userId); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	return nil
+}
+
+// PurgeSessions deletes sessions that expired or were revoked before the given
+// time together with their tokens, as well as tokens of live sessions rotated
+// out before it, and reports how many rows were deleted.
+func (r *BlogRepository) PurgeSessions(before time.Time) (int, error) {
+	tx, err := r.DB.Begin()
+	if err != nil {
+		log.Println(err)
+		return 0, errors.ErrInternalServerError
+	}
+	defer tx.Rollback()
+
+	purged := 0
+	queries := []string{
+		`DELETE FROM session_tokens WHERE rotated_at < $1`,
+		`DELETE FROM sessions WHERE expires_at < $1 OR revoked_at < $1`,
+	}
+	for _, query := range queries {
+		var result sql.Result
+		if result, err = tx.Exec(query, before); err != nil {
+			log.Println(err)
+			return 0, errors.ErrInternalServerError
+		}
+
+		var affected int64
+		if affected, err = result.RowsAffected(); err != nil {
+			log.Println(err)
+			return 0, errors.ErrInternalServerError
+		}
+		purged += int(affected)
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Println(err)
+		return 0, errors.ErrInternalServerError
+	}
+
+	return purged, nil
+}
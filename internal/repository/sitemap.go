@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"log"
+)
+
+// sitemapEntriesQuery lists published posts, then authors and tags that have
+// published posts, each with the time of their latest change.
+const sitemapEntriesQuery = `SELECT $2::text, slug, updated_at, 1 AS ord FROM posts WHERE status = $1 AND deleted_at IS NULL
+	UNION ALL
+	SELECT $3::text, author_id::text, MAX(updated_at), 2 FROM posts WHERE status = $1 AND deleted_at IS NULL GROUP BY author_id
+	UNION ALL
+	SELECT $4::text, tags.slug, MAX(posts.updated_at), 3 FROM tags JOIN post_tags USING (tag_id) JOIN posts USING (post_id)
+	WHERE posts.status = $1 AND posts.deleted_at IS NULL GROUP BY tags.slug`
+
+func (r *BlogRepository) CountSitemapEntries() (int, error) {
+	var count int
+
+	query := `SELECT COUNT(*) FROM (` + sitemapEntriesQuery + `) AS entries`
+	err := r.DB.QueryRow(query, consts.PublishedState, consts.PostSitemapEntry, consts.AuthorSitemapEntry, consts.TagSitemapEntry).Scan(&count)
+	if err != nil {
+		log.Println(err)
+		return 0, errors.ErrInternalServerError
+	}
+
+	return count, nil
+}
+
+func (r *BlogRepository) GetSitemapEntries(limit, offset int) ([]*entities.SitemapEntry, error) {
+	var entries []*entities.SitemapEntry
+
+	query := `SELECT kind, key, lastmod FROM (` + sitemapEntriesQuery + `) AS entries (kind, key, lastmod, ord)
+	ORDER BY ord, key LIMIT $5 OFFSET $6`
+	rows, err := r.DB.Query(query, consts.PublishedState, consts.PostSitemapEntry, consts.AuthorSitemapEntry, consts.TagSitemapEntry, limit, offset)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entry entities.SitemapEntry
+		if err = rows.Scan(&entry.Kind, &entry.Key, &entry.UpdatedAt); err != nil {
+			log.Println(err)
+			return nil, errors.ErrInternalServerError
+		}
+		entries = append(entries, &entry)
+	}
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return entries, nil
+}
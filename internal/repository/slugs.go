@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"blog/pkg/consts/errors"
+	"database/sql"
+	stderr "errors"
+	"log"
+)
+
+// GetPostIdBySlug resolves both current and previous slugs. redirect reports
+// that the slug belonged to the post before its title changed.
+func (r *BlogRepository) GetPostIdBySlug(slug string) (postId string, redirect bool, err error) {
+	query := `SELECT post_id, false FROM posts WHERE slug = $1
+	UNION ALL
+	SELECT post_id, true FROM post_slug_redirects WHERE slug = $1
+	LIMIT 1`
+	err = r.DB.QueryRow(query, slug).Scan(&postId, &redirect)
+	if err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return "", false, errors.ErrPostNotFound
+		}
+		log.Println(err)
+		return "", false, errors.ErrInternalServerError
+	}
+
+	return postId, redirect, nil
+}
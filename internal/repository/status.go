@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"database/sql"
+	stderr "errors"
+	"log"
+	"time"
+)
+
+func (r *BlogRepository) TransitPostStatus(postId, changedBy, from, to string, version int, publishAt *time.Time, changedAt time.Time) (*entities.Post, error) {
+	var post entities.Post
+
+	tx, err := r.DB.Begin()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE posts SET status = $1, publish_at = $2, updated_at = $3, version = version + 1 WHERE post_id = $4 AND status = $5 AND version = $6 RETURNING ` + postColumns
+	err = scanPost(tx.QueryRow(query, to, publishAt, changedAt, postId, from, version), &post)
+	if err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrPostVersionMismatch
+		}
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	query = `INSERT INTO post_status_history (post_id, changed_by, from_status, to_status, changed_at) VALUES ($1, $2, $3, $4, $5)`
+	if _, err = tx.Exec(query, postId, changedBy, from, to, changedAt); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return &post, nil
+}
+
+func (r *BlogRepository) GetPostStatusHistory(postId string) ([]*entities.StatusTransition, error) {
+	var transitions []*entities.StatusTransition
+
+	query := `SELECT transition_id, post_id, changed_by, from_status, to_status, changed_at FROM post_status_history WHERE post_id = $1 ORDER BY changed_at DESC, transition_id DESC`
+	rows, err := r.DB.Query(query, postId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var transition entities.StatusTransition
+		err = rows.Scan(&transition.TransitionId, &transition.PostId, &transition.ChangedBy, &transition.FromStatus, &transition.ToStatus, &transition.ChangedAt)
+		if err != nil {
+			log.Println(err)
+			return nil, errors.ErrInternalServerError
+		}
+		transitions = append(transitions, &transition)
+	}
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return transitions, nil
+}
+
+// PublishDuePosts publishes scheduled posts whose time has come. Rows locked by
+// another instance are skipped, so each post is published exactly once.
+func (r *BlogRepository) PublishDuePosts(now time.Time, limit int) (int, error) {
+	query := `WITH due AS (
+		SELECT post_id FROM posts
+		WHERE status = $1 AND publish_at <= $2 AND deleted_at IS NULL
+		ORDER BY publish_at
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED
+	), published AS (
+		UPDATE posts SET status = $4, updated_at = $2, version = version + 1
+		FROM due
+		WHERE posts.post_id = due.post_id
+		RETURNING posts.post_id, posts.author_id
+	)
+	INSERT INTO post_status_history (post_id, changed_by, from_status, to_status, changed_at)
+	SELECT post_id, author_id, $1, $4, $2 FROM published`
+	result, err := r.DB.Exec(query, consts.ScheduledState, now, limit, consts.PublishedState)
+	if err != nil {
+		log.Println(err)
+		return 0, errors.ErrInternalServerError
+	}
+
+	published, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return 0, errors.ErrInternalServerError
+	}
+
+	return int(published), nil
+}
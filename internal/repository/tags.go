@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"database/sql"
+	stderr "errors"
+	"log"
+	"slices"
+
+	"github.com/lib/pq"
+)
+
+// replacePostTags makes the tags the only tags of the post within the
+// transaction and reports whether the set of tags changed.
+func replacePostTags(tx *sql.Tx, postId string, tags []entities.Tag) (bool, error) {
+	var current pq.StringArray
+
+	slugs := make([]string, 0, len(tags))
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		slugs = append(slugs, tag.Slug)
+		names = append(names, tag.Name)
+	}
+
+	query := `SELECT COALESCE(array_agg(tags.slug), '{}') FROM post_tags JOIN tags USING (tag_id) WHERE post_tags.post_id = $1`
+	if err := tx.QueryRow(query, postId).Scan(&current); err != nil {
+		log.Println(err)
+		return false, errors.ErrInternalServerError
+	}
+	sorted := slices.Clone(slugs)
+	slices.Sort(sorted)
+	slices.Sort(current)
+	if slices.Equal(sorted, []string(current)) {
+		return false, nil
+	}
+
+	query = `DELETE FROM post_tags WHERE post_id = $1`
+	if _, err := tx.Exec(query, postId); err != nil {
+		log.Println(err)
+		return false, errors.ErrInternalServerError
+	}
+
+	if len(tags) > 0 {
+		query = `INSERT INTO tags (slug, name) SELECT * FROM unnest($1::text[], $2::text[]) ON CONFLICT (slug) DO NOTHING`
+		if _, err := tx.Exec(query, pq.Array(slugs), pq.Array(names)); err != nil {
+			log.Println(err)
+			return false, errors.ErrInternalServerError
+		}
+
+		query = `INSERT INTO post_tags (post_id, tag_id) SELECT $1, tag_id FROM tags WHERE slug = ANY($2)`
+		if _, err := tx.Exec(query, postId, pq.Array(slugs)); err != nil {
+			log.Println(err)
+			return false, errors.ErrInternalServerError
+		}
+	}
+
+	return true, nil
+}
+
+func (r *BlogRepository) GetTags() ([]*entities.Tag, error) {
+	var tags []*entities.Tag
+
+	query := `SELECT tags.tag_id, tags.slug, tags.name, COUNT(posts.post_id)
+	FROM tags
+	JOIN post_tags ON post_tags.tag_id = tags.tag_id
+	JOIN posts ON posts.post_id = post_tags.post_id AND posts.status = $1 AND posts.deleted_at IS NULL
+	GROUP BY tags.tag_id
+	ORDER BY COUNT(posts.post_id) DESC, tags.slug`
+	rows, err := r.DB.Query(query, consts.PublishedState)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tag entities.Tag
+		err = rows.Scan(&tag.TagId, &tag.Slug, &tag.Name, &tag.PostsCount)
+		if err != nil {
+			log.Println(err)
+			return nil, errors.ErrInternalServerError
+		}
+		tags = append(tags, &tag)
+	}
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return tags, nil
+}
+
+func (r *BlogRepository) GetTagBySlug(slug string) (*entities.Tag, error) {
+	var tag entities.Tag
+
+	query := `SELECT tag_id, slug, name FROM tags WHERE slug = $1`
+	err := r.DB.QueryRow(query, slug).Scan(&tag.TagId, &tag.Slug, &tag.Name)
+	if err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrTagNotFound
+		}
+		log.Println(err)
+		return nil, errors.ErrInternalServerError
+	}
+
+	return &tag, nil
+}
+
+func (r *BlogRepository) loadPostsTags(posts []*entities.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	postsById := make(map[string]*entities.Post, len(posts))
+	postIds := make([]string, 0, len(posts))
+	for _, post := range posts {
+		postsById[post.PostId] = post
+		postIds = append(postIds, post.PostId)
+	}
+
+	query := `SELECT post_tags.post_id, tags.tag_id, tags.slug, tags.name FROM post_tags JOIN tags USING (tag_id) WHERE post_tags.post_id = ANY($1) ORDER BY tags.slug`
+	rows, err := r.DB.Query(query, pq.Array(postIds))
+	if err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var postId string
+		var tag entities.Tag
+		err = rows.Scan(&postId, &tag.TagId, &tag.Slug, &tag.Name)
+		if err != nil {
+			log.Println(err)
+			return errors.ErrInternalServerError
+		}
+		if post, ok := postsById[postId]; ok {
+			post.Tags = append(post.Tags, tag)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return errors.ErrInternalServerError
+	}
+
+	return nil
+}
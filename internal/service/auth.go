@@ -1,41 +1,75 @@
 package service
 
 import (
+	"blog/internal/mailer"
 	"blog/internal/models/dto"
 	"blog/internal/models/entities"
+	"blog/internal/validation"
 	"blog/pkg/consts"
 	"blog/pkg/consts/errors"
 	"blog/pkg/utils/hash"
 	"blog/pkg/utils/jwt"
 	"blog/pkg/utils/mail"
+	stderr "errors"
+	"fmt"
+	"net/url"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 )
 
 type AuthBlogRepository interface {
-	CreateUser(email, passwordHash, role, refreshToken string, refreshTokenExpiryTime time.Time) (*entities.User, error)
+	CreateUser(email, passwordHash, role string) (*entities.User, error)
 	GetUserByEmail(email string) (*entities.User, error)
-	GetUserByRefreshToken(refreshToken string) (*entities.User, error)
 	GetUserById(userId string) (*entities.User, error)
-	UpdateRefreshToken(userId, refreshToken string) error
+	CreateSession(sessionId, userId, tokenHash, device, userAgent, ip string, createdAt, expiresAt time.Time) error
+	RotateSessionToken(sessionId, tokenHash, newTokenHash string, usedAt, expiresAt time.Time) (*entities.Session, error)
+	GetActiveSessionByToken(sessionId, tokenHash string, now time.Time) (*entities.Session, error)
+	GetSessionById(sessionId string) (*entities.Session, error)
+	RevokeSession(userId, sessionId string, revokedAt time.Time) error
+	RevokeAllTokens(userId string, validAfter time.Time) error
+	CreatePasswordResetToken(userId, tokenHash string, createdAt, expiresAt time.Time) error
+	GetLastPasswordResetTokenTime(userId string) (*time.Time, error)
+	ResetPassword(tokenHash, passwordHash string, resetAt time.Time) error
+	CreateVerificationToken(userId, tokenHash string, createdAt, expiresAt time.Time) error
+	GetLastVerificationTokenTime(userId string) (*time.Time, error)
+	VerifyEmail(tokenHash string, verifiedAt time.Time) error
+}
+
+// AuthMailConfig holds the pages emailed links lead to and how long the
+// links stay valid.
+type AuthMailConfig struct {
+	PasswordResetURL           string
+	PasswordResetTTL           time.Duration
+	PasswordResetInterval      time.Duration
+	VerificationURL            string
+	VerificationTTL            time.Duration
+	VerificationResendInterval time.Duration
 }
 
 type AuthService struct {
-	repo   AuthBlogRepository
-	secret string
+	repo           AuthBlogRepository
+	secret         string
+	sender         mailer.Sender
+	emailValidator validation.EmailValidator
+	mailCfg        AuthMailConfig
 }
 
-func NewAuthService(repo AuthBlogRepository, secret string) *AuthService {
+func NewAuthService(repo AuthBlogRepository, secret string, sender mailer.Sender, emailValidator validation.EmailValidator, mailCfg AuthMailConfig) *AuthService {
 	return &AuthService{
-		repo:   repo,
-		secret: secret,
+		repo:           repo,
+		secret:         secret,
+		sender:         sender,
+		emailValidator: emailValidator,
+		mailCfg:        mailCfg,
 	}
 }
 
 func (s *AuthService) RegistrateUser(user *dto.RegistrateUserRequest) (*dto.RegistrateUserResponse, error) {
-	if !mail.IsValidEmail(user.Email) {
-		return nil, errors.ErrInvalidEmail
+	if err := s.emailValidator.Validate(user.Email); err != nil {
+		return nil, err
 	}
 
 	if user.Role != consts.AuthorRole && user.Role != consts.ReaderRole {
@@ -47,20 +81,21 @@ func (s *AuthService) RegistrateUser(user *dto.RegistrateUserRequest) (*dto.Regi
 		return nil, err
 	}
 
-	refreshToken, err := jwt.NewRefreshToken(user.Email, s.secret)
+	newUser, err := s.repo.CreateUser(user.Email, passwordHash, user.Role)
 	if err != nil {
 		return nil, err
 	}
 
-	refreshTokenExpiryTime := time.Now().Add(time.Hour * 24 * 7)
+	// The account already exists at this point, so a failed email must not
+	// fail the registration: the user can ask for a new link via resend. The
+	// repository and the sender log their own failures.
+	_ = s.sendVerification(newUser)
 
-	newUser, err := s.repo.CreateUser(user.Email, passwordHash, user.Role, refreshToken, refreshTokenExpiryTime)
+	accessToken, refreshToken, err := s.createSession(newUser, user.Device, user.UserAgent, user.IP)
 	if err != nil {
 		return nil, err
 	}
 
-	accessToken := jwt.NewAccessToken(newUser.UserId, s.secret)
-
 	var message string
 	if newUser != nil {
 		message = "registered successfully"
@@ -93,17 +128,11 @@ func (s *AuthService) LoginUser(user *dto.LoginUserRequest) (*dto.LoginUserRespo
 		return nil, errors.ErrInvalidEmailOrPassword
 	}
 
-	refreshToken, err := jwt.NewRefreshToken(user.Email, s.secret)
+	accessToken, refreshToken, err := s.createSession(newUser, user.Device, user.UserAgent, user.IP)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = s.repo.UpdateRefreshToken(newUser.UserId, refreshToken); err != nil {
-		return nil, err
-	}
-
-	accessToken := jwt.NewAccessToken(newUser.UserId, s.secret)
-
 	var message string
 	if newUser != nil {
 		message = "logged in successfully"
@@ -118,28 +147,48 @@ func (s *AuthService) LoginUser(user *dto.LoginUserRequest) (*dto.LoginUserRespo
 	return responseUser, nil
 }
 
+// RefreshUserToken exchanges the refresh token for a new pair of tokens of
+// the same session. The presented refresh token stops working; presenting it
+// again revokes the whole session.
 func (s *AuthService) RefreshUserToken(token *dto.RefreshUserTokenRequest) (*dto.RefreshUserTokenResponse, error) {
-	_, err := jwt.ValidateToken(token.RefreshToken, s.secret)
+	claims, err := jwt.ValidateToken(token.RefreshToken, s.secret)
 	if err != nil {
 		return nil, errors.ErrInvalidRefreshToken
 	}
 
-	newUser, err := s.repo.GetUserByRefreshToken(token.RefreshToken)
+	sessionId := jwt.SessionId(claims)
+	if sessionId == "" {
+		return nil, errors.ErrInvalidRefreshToken
+	}
+
+	session, err := s.repo.GetSessionById(sessionId)
 	if err != nil {
+		if stderr.Is(err, errors.ErrSessionNotFound) {
+			return nil, errors.ErrInvalidRefreshToken
+		}
 		return nil, err
 	}
 
-	accessToken := jwt.NewAccessToken(newUser.UserId, s.secret)
+	user, err := s.repo.GetUserById(session.UserId)
+	if err != nil {
+		return nil, err
+	}
 
-	var message string
-	if newUser != nil {
-		message = "refresh tokens successfully"
+	refreshToken, err := jwt.NewRefreshToken(user.Email, sessionId, s.secret)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	_, err = s.repo.RotateSessionToken(sessionId, hash.SHA256String(token.RefreshToken), hash.SHA256String(refreshToken), now, now.Add(jwt.RefreshTokenTTL))
+	if err != nil {
+		return nil, err
 	}
 
 	responseToken := &dto.RefreshUserTokenResponse{
-		Message:      message,
-		AccessToken:  accessToken,
-		RefreshToken: token.RefreshToken,
+		Message:      "refresh tokens successfully",
+		AccessToken:  jwt.NewAccessToken(user.UserId, sessionId, s.secret),
+		RefreshToken: refreshToken,
 	}
 
 	return responseToken, nil
@@ -164,6 +213,253 @@ func (s *AuthService) AuthorizeUser(token string) (*entities.User, error) {
 	if err != nil {
 		return nil, err
 	}
+	if isRevoked(jwt.IssuedAt(claims), user) {
+		return nil, errors.ErrInvalidAccessToken
+	}
+
+	sessionId := jwt.SessionId(claims)
+	if sessionId == "" {
+		return nil, errors.ErrInvalidAccessToken
+	}
+
+	session, err := s.repo.GetSessionById(sessionId)
+	if err != nil {
+		if stderr.Is(err, errors.ErrSessionNotFound) {
+			return nil, errors.ErrInvalidAccessToken
+		}
+		return nil, err
+	}
+	if session.UserId != user.UserId || session.RevokedAt != nil {
+		return nil, errors.ErrInvalidAccessToken
+	}
+	user.SessionId = session.SessionId
 
 	return user, nil
 }
+
+func (s *AuthService) LogoutUser(rows *dto.LogoutUserRequest) (*dto.LogoutUserResponse, error) {
+	session, err := s.getSessionByRefreshToken(rows.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.RevokeSession(session.UserId, session.SessionId, time.Now()); err != nil {
+		return nil, err
+	}
+
+	response := &dto.LogoutUserResponse{
+		Message: "logged out successfully",
+	}
+
+	return response, nil
+}
+
+// LogoutUserEverywhere revokes every session of the user and every access
+// token issued to the user so far.
+func (s *AuthService) LogoutUserEverywhere(rows *dto.LogoutUserRequest) (*dto.LogoutUserResponse, error) {
+	session, err := s.getSessionByRefreshToken(rows.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.RevokeAllTokens(session.UserId, time.Now().Truncate(time.Second)); err != nil {
+		return nil, err
+	}
+
+	response := &dto.LogoutUserResponse{
+		Message: "logged out from all sessions successfully",
+	}
+
+	return response, nil
+}
+
+// ForgotPassword mails a password reset link to the user, at most once per
+// reset interval. The response is the same whether the account exists or
+// not, and whether a link was sent or not.
+func (s *AuthService) ForgotPassword(rows *dto.ForgotPasswordRequest) (*dto.ForgotPasswordResponse, error) {
+	if !mail.IsValidEmail(rows.Email) {
+		return nil, errors.ErrInvalidEmail
+	}
+
+	response := &dto.ForgotPasswordResponse{
+		Message: "if the account exists, a password reset link has been sent",
+	}
+
+	user, err := s.repo.GetUserByEmail(rows.Email)
+	if err != nil {
+		if stderr.Is(err, errors.ErrInvalidEmailOrPassword) {
+			return response, nil
+		}
+		return nil, err
+	}
+
+	lastSentAt, err := s.repo.GetLastPasswordResetTokenTime(user.UserId)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if lastSentAt != nil && now.Before(lastSentAt.Add(s.mailCfg.PasswordResetInterval)) {
+		return response, nil
+	}
+
+	token, err := hash.NewToken()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.CreatePasswordResetToken(user.UserId, hash.SHA256String(token), now, now.Add(s.mailCfg.PasswordResetTTL)); err != nil {
+		return nil, err
+	}
+
+	// The sender delivers in the background and logs its own failures. A send
+	// error must not change the response, otherwise it would reveal that the
+	// account exists.
+	_ = s.sender.Send(&mailer.Message{
+		To:      user.Email,
+		Subject: "Password reset",
+		Body: fmt.Sprintf("To set a new password, follow the link:\n%s\n\nThe link is valid for %s and can be used once. If you did not request a reset, ignore this email.",
+			mailLink(s.mailCfg.PasswordResetURL, token), s.mailCfg.PasswordResetTTL),
+	})
+
+	return response, nil
+}
+
+// ResetPassword sets a new password by a reset token and signs the user out
+// of every session.
+func (s *AuthService) ResetPassword(rows *dto.ResetPasswordRequest) (*dto.ResetPasswordResponse, error) {
+	if rows.Token == "" {
+		return nil, errors.ErrInvalidResetToken
+	}
+	if rows.Password == "" {
+		return nil, errors.ErrInvalidPassword
+	}
+
+	passwordHash, err := hash.HashString(rows.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.ResetPassword(hash.SHA256String(rows.Token), passwordHash, time.Now().Truncate(time.Second)); err != nil {
+		return nil, err
+	}
+
+	response := &dto.ResetPasswordResponse{
+		Message: "password reset successfully",
+	}
+
+	return response, nil
+}
+
+func (s *AuthService) VerifyEmail(rows *dto.VerifyEmailRequest) (*dto.VerifyEmailResponse, error) {
+	if rows.Token == "" {
+		return nil, errors.ErrInvalidVerificationToken
+	}
+
+	if err := s.repo.VerifyEmail(hash.SHA256String(rows.Token), time.Now()); err != nil {
+		return nil, err
+	}
+
+	response := &dto.VerifyEmailResponse{
+		Message: "email verified successfully",
+	}
+
+	return response, nil
+}
+
+// ResendVerification mails a new verification link, at most once per
+// resend interval.
+func (s *AuthService) ResendVerification(rows *dto.ResendVerificationRequest) (*dto.ResendVerificationResponse, error) {
+	user, err := s.repo.GetUserById(rows.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user.EmailVerifiedAt != nil {
+		return nil, errors.ErrEmailAlreadyVerified
+	}
+
+	lastSentAt, err := s.repo.GetLastVerificationTokenTime(user.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if lastSentAt != nil && time.Now().Before(lastSentAt.Add(s.mailCfg.VerificationResendInterval)) {
+		return nil, errors.ErrTooManyRequests
+	}
+
+	if err = s.sendVerification(user); err != nil {
+		return nil, err
+	}
+
+	response := &dto.ResendVerificationResponse{
+		Message: "verification email sent",
+	}
+
+	return response, nil
+}
+
+func (s *AuthService) sendVerification(user *entities.User) error {
+	token, err := hash.NewToken()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if err = s.repo.CreateVerificationToken(user.UserId, hash.SHA256String(token), now, now.Add(s.mailCfg.VerificationTTL)); err != nil {
+		return err
+	}
+
+	return s.sender.Send(&mailer.Message{
+		To:      user.Email,
+		Subject: "Confirm your email",
+		Body: fmt.Sprintf("To confirm your email, follow the link:\n%s\n\nThe link is valid for %s. Until the email is confirmed you cannot publish posts.",
+			mailLink(s.mailCfg.VerificationURL, token), s.mailCfg.VerificationTTL),
+	})
+}
+
+func (s *AuthService) createSession(user *entities.User, device, userAgent, ip string) (string, string, error) {
+	sessionId := uuid.NewString()
+
+	refreshToken, err := jwt.NewRefreshToken(user.Email, sessionId, s.secret)
+	if err != nil {
+		return "", "", err
+	}
+
+	now := time.Now()
+	err = s.repo.CreateSession(sessionId, user.UserId, hash.SHA256String(refreshToken), normalizeDevice(device), userAgent, ip, now, now.Add(jwt.RefreshTokenTTL))
+	if err != nil {
+		return "", "", err
+	}
+
+	return jwt.NewAccessToken(user.UserId, sessionId, s.secret), refreshToken, nil
+}
+
+// getSessionByRefreshToken returns the active session the refresh token is
+// the current token of.
+func (s *AuthService) getSessionByRefreshToken(refreshToken string) (*entities.Session, error) {
+	claims, err := jwt.ValidateToken(refreshToken, s.secret)
+	if err != nil {
+		return nil, errors.ErrInvalidRefreshToken
+	}
+
+	sessionId := jwt.SessionId(claims)
+	if sessionId == "" {
+		return nil, errors.ErrInvalidRefreshToken
+	}
+
+	return s.repo.GetActiveSessionByToken(sessionId, hash.SHA256String(refreshToken), time.Now())
+}
+
+func isRevoked(issuedAt time.Time, user *entities.User) bool {
+	return user.TokensValidAfter != nil && issuedAt.Before(*user.TokensValidAfter)
+}
+
+func normalizeDevice(device string) string {
+	device = strings.TrimSpace(device)
+	if utf8.RuneCountInString(device) > consts.MaxDeviceLength {
+		device = string([]rune(device)[:consts.MaxDeviceLength])
+	}
+	return device
+}
+
+func mailLink(page, token string) string {
+	return page + "?token=" + url.QueryEscape(token)
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts/errors"
+
+	"github.com/google/uuid"
+)
+
+type AuthorsBlogRepository interface {
+	GetAuthorById(authorId string) (*entities.Author, error)
+	GetAllPosts(filter *dto.PostsFilter) ([]*entities.Post, error)
+}
+
+type AuthorsService struct {
+	repo AuthorsBlogRepository
+}
+
+func NewAuthorsService(repo AuthorsBlogRepository) *AuthorsService {
+	return &AuthorsService{
+		repo: repo,
+	}
+}
+
+func (s *AuthorsService) ViewAuthor(rows *dto.GetAuthorRequest) (*dto.GetAuthorResponse, error) {
+	author, err := s.getAuthor(rows.AuthorId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.GetAuthorResponse{
+		Author: *author,
+	}
+
+	return response, nil
+}
+
+func (s *AuthorsService) ViewAuthorPosts(rows *dto.GetAuthorPostsRequest) (*dto.GetPostsResponse, error) {
+	author, err := s.getAuthor(rows.AuthorId)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := newPostsFilter(&rows.PostsQuery)
+	if err != nil {
+		return nil, err
+	}
+	filter.AuthorId = author.AuthorId
+
+	posts, err := s.repo.GetAllPosts(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPostsPage(posts, filter), nil
+}
+
+func (s *AuthorsService) getAuthor(authorId string) (*entities.Author, error) {
+	if _, err := uuid.Parse(authorId); err != nil {
+		return nil, errors.ErrAuthorNotFound
+	}
+
+	return s.repo.GetAuthorById(authorId)
+}
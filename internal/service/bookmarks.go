@@ -0,0 +1,120 @@
+package service
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"blog/pkg/utils/cursor"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const bookmarksCursorKey = "bookmarks"
+
+type BookmarksBlogRepository interface {
+	GetPostById(postId string) (*entities.Post, error)
+	AddBookmark(userId, postId string, createdAt time.Time) error
+	DeleteBookmark(userId, postId string) error
+	GetUserBookmarks(userId string, cursorValue *time.Time, cursorPostId string, limit int) ([]*entities.BookmarkedPost, error)
+}
+
+type BookmarksService struct {
+	repo BookmarksBlogRepository
+}
+
+func NewBookmarksService(repo BookmarksBlogRepository) *BookmarksService {
+	return &BookmarksService{
+		repo: repo,
+	}
+}
+
+func (s *BookmarksService) AddBookmark(rows *dto.AddBookmarkRequest) (*dto.AddBookmarkResponse, error) {
+	post, err := getPublishedPost(s.repo, rows.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.AddBookmark(rows.UserId, post.PostId, time.Now()); err != nil {
+		return nil, err
+	}
+
+	response := &dto.AddBookmarkResponse{
+		Message: "bookmark added successfully",
+	}
+
+	return response, nil
+}
+
+func (s *BookmarksService) DeleteBookmark(rows *dto.DeleteBookmarkRequest) (*dto.DeleteBookmarkResponse, error) {
+	if _, err := uuid.Parse(rows.PostId); err != nil {
+		return nil, errors.ErrBookmarkNotFound
+	}
+
+	if err := s.repo.DeleteBookmark(rows.UserId, rows.PostId); err != nil {
+		return nil, err
+	}
+
+	response := &dto.DeleteBookmarkResponse{
+		Message: "bookmark deleted successfully",
+	}
+
+	return response, nil
+}
+
+func (s *BookmarksService) ViewBookmarks(rows *dto.GetBookmarksRequest) (*dto.GetBookmarksResponse, error) {
+	limit := rows.Limit
+	if limit == 0 {
+		limit = consts.DefaultBookmarksLimit
+	}
+	if limit < 0 || limit > consts.MaxBookmarksLimit {
+		return nil, errors.ErrInvalidQueryParams
+	}
+
+	var cursorValue *time.Time
+	var cursorPostId string
+	if rows.Cursor != "" {
+		value, postId, err := cursor.Decode(rows.Cursor, bookmarksCursorKey)
+		if err != nil {
+			return nil, errors.ErrInvalidCursor
+		}
+		cursorValue, cursorPostId = &value, postId
+	}
+
+	posts, err := s.repo.GetUserBookmarks(rows.UserId, cursorValue, cursorPostId, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.GetBookmarksResponse{
+		Posts: make([]entities.BookmarkedPost, 0, len(posts)),
+	}
+	if len(posts) > limit {
+		posts = posts[:limit]
+		last := posts[len(posts)-1]
+		response.NextCursor = cursor.Encode(bookmarksCursorKey, last.BookmarkedAt, last.Post.PostId)
+	}
+	for _, post := range posts {
+		response.Posts = append(response.Posts, *post)
+	}
+
+	return response, nil
+}
+
+type publishedPostsRepository interface {
+	GetPostById(postId string) (*entities.Post, error)
+}
+
+func getPublishedPost(repo publishedPostsRepository, postId string) (*entities.Post, error) {
+	if _, err := uuid.Parse(postId); err != nil {
+		return nil, errors.ErrPostNotFound
+	}
+
+	post, err := repo.GetPostById(postId)
+	if err != nil || post.DeletedAt != nil || post.Status != consts.PublishedState {
+		return nil, errors.ErrPostNotFound
+	}
+
+	return post, nil
+}
@@ -0,0 +1,408 @@
+package service
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/internal/moderation"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"blog/pkg/utils/cursor"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	commentsCursorKey = "comments"
+	repliesCursorKey  = "replies"
+)
+
+type CommentsBlogRepository interface {
+	GetPostById(postId string) (*entities.Post, error)
+	CreateComment(postId, authorId string, parentId *string, content, status, spamReason string, createdAt time.Time) (*entities.Comment, error)
+	GetCommentById(commentId string) (*entities.Comment, error)
+	EditComment(commentId, content, status, spamReason string, updatedAt time.Time) (*entities.Comment, error)
+	DeleteComment(commentId string, deletedAt time.Time) error
+	GetPostComments(postId string, cursorValue *time.Time, cursorCommentId string, limit int) ([]*entities.Comment, error)
+	GetCommentsReplies(commentIds []string, limit int) ([]*entities.Comment, error)
+	GetCommentReplies(commentId string, cursorValue *time.Time, cursorCommentId string, limit int) ([]*entities.Comment, error)
+}
+
+type CommentsService struct {
+	repo        CommentsBlogRepository
+	spamChecker moderation.SpamChecker
+}
+
+func NewCommentsService(repo CommentsBlogRepository, spamChecker moderation.SpamChecker) *CommentsService {
+	return &CommentsService{
+		repo:        repo,
+		spamChecker: spamChecker,
+	}
+}
+
+func (s *CommentsService) CreateComment(rows *dto.CreateCommentRequest) (*dto.CreateCommentResponse, error) {
+	content, err := normalizeComment(rows.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := s.getPost(rows.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if post.Status != consts.PublishedState {
+		return nil, errors.ErrPostNotFound
+	}
+
+	if rows.ParentId != nil {
+		parent, err := s.getPostComment(post.PostId, *rows.ParentId)
+		if err != nil || parent.Status != consts.ApprovedCommentState {
+			return nil, errors.ErrInvalidParentComment
+		}
+	}
+
+	verdict, err := s.spamChecker.Check(&entities.Comment{
+		PostId:   post.PostId,
+		AuthorId: rows.AuthorId,
+		Content:  content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.repo.CreateComment(post.PostId, rows.AuthorId, rows.ParentId, content, verdict.Status, verdict.Reason, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.CreateCommentResponse{
+		Message: commentMessage(comment.Status, "comment created successfully"),
+		Comment: *comment,
+	}
+
+	return response, nil
+}
+
+func (s *CommentsService) EditComment(rows *dto.EditCommentRequest) (*dto.EditCommentResponse, error) {
+	content, err := normalizeComment(rows.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := s.getPost(rows.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.getPostComment(post.PostId, rows.CommentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.AuthorId != rows.AuthorId {
+		return nil, errors.ErrNoPermission
+	}
+
+	comment.Content = content
+	verdict, err := s.spamChecker.Check(comment)
+	if err != nil {
+		return nil, err
+	}
+	verdict = editedCommentVerdict(comment, verdict)
+
+	comment, err = s.repo.EditComment(comment.CommentId, content, verdict.Status, verdict.Reason, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.EditCommentResponse{
+		Message: commentMessage(comment.Status, "comment edited successfully"),
+	}
+
+	return response, nil
+}
+
+func (s *CommentsService) DeleteComment(rows *dto.DeleteCommentRequest) (*dto.DeleteCommentResponse, error) {
+	post, err := s.getPost(rows.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.getPostComment(post.PostId, rows.CommentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.AuthorId != rows.UserId && post.AuthorId != rows.UserId {
+		return nil, errors.ErrNoPermission
+	}
+
+	if err = s.repo.DeleteComment(comment.CommentId, time.Now()); err != nil {
+		return nil, err
+	}
+
+	response := &dto.DeleteCommentResponse{
+		Message: "comment deleted successfully",
+	}
+
+	return response, nil
+}
+
+func (s *CommentsService) ViewComments(rows *dto.GetCommentsRequest) (*dto.GetCommentsResponse, error) {
+	limit := rows.Limit
+	if limit == 0 {
+		limit = consts.DefaultCommentsLimit
+	}
+	if limit < 0 || limit > consts.MaxCommentsLimit {
+		return nil, errors.ErrInvalidQueryParams
+	}
+
+	var cursorValue *time.Time
+	var cursorCommentId string
+	if rows.Cursor != "" {
+		value, commentId, err := cursor.Decode(rows.Cursor, commentsCursorKey)
+		if err != nil {
+			return nil, errors.ErrInvalidCursor
+		}
+		cursorValue, cursorCommentId = &value, commentId
+	}
+
+	post, err := s.getPost(rows.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if !isReadablePostStatus(post.Status) && post.AuthorId != rows.UserId {
+		return nil, errors.ErrPostNotFound
+	}
+
+	threads, err := s.repo.GetPostComments(post.PostId, cursorValue, cursorCommentId, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.GetCommentsResponse{}
+	if len(threads) > limit {
+		threads = threads[:limit]
+		last := threads[len(threads)-1]
+		response.NextCursor = cursor.Encode(commentsCursorKey, last.CreatedAt, last.CommentId)
+	}
+
+	threadIds := make([]string, 0, len(threads))
+	for _, thread := range threads {
+		threadIds = append(threadIds, thread.CommentId)
+	}
+
+	replies, err := s.repo.GetCommentsReplies(threadIds, consts.MaxThreadReplies+1)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Comments = buildCommentsTree(threads, limitThreadsReplies(threads, replies))
+
+	return response, nil
+}
+
+// ViewCommentReplies pages through the replies below the comment past the ones
+// returned together with its thread.
+func (s *CommentsService) ViewCommentReplies(rows *dto.GetCommentRepliesRequest) (*dto.GetCommentRepliesResponse, error) {
+	limit := rows.Limit
+	if limit == 0 {
+		limit = consts.DefaultCommentsLimit
+	}
+	if limit < 0 || limit > consts.MaxCommentsLimit {
+		return nil, errors.ErrInvalidQueryParams
+	}
+
+	var cursorValue *time.Time
+	var cursorCommentId string
+	if rows.Cursor != "" {
+		value, commentId, err := cursor.Decode(rows.Cursor, repliesCursorKey)
+		if err != nil {
+			return nil, errors.ErrInvalidCursor
+		}
+		cursorValue, cursorCommentId = &value, commentId
+	}
+
+	post, err := s.getPost(rows.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if !isReadablePostStatus(post.Status) && post.AuthorId != rows.UserId {
+		return nil, errors.ErrPostNotFound
+	}
+
+	if _, err = uuid.Parse(rows.CommentId); err != nil {
+		return nil, errors.ErrCommentNotFound
+	}
+	comment, err := s.repo.GetCommentById(rows.CommentId)
+	if err != nil {
+		return nil, err
+	}
+	if comment.PostId != post.PostId || comment.Status != consts.ApprovedCommentState {
+		return nil, errors.ErrCommentNotFound
+	}
+
+	replies, err := s.repo.GetCommentReplies(comment.CommentId, cursorValue, cursorCommentId, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.GetCommentRepliesResponse{
+		Replies: make([]entities.Comment, 0, len(replies)),
+	}
+	if len(replies) > limit {
+		replies = replies[:limit]
+		last := replies[len(replies)-1]
+		response.NextCursor = cursor.Encode(repliesCursorKey, last.CreatedAt, last.CommentId)
+	}
+	for _, reply := range replies {
+		response.Replies = append(response.Replies, publicComment(reply))
+	}
+
+	return response, nil
+}
+
+func (s *CommentsService) getPost(postId string) (*entities.Post, error) {
+	if _, err := uuid.Parse(postId); err != nil {
+		return nil, errors.ErrPostNotFound
+	}
+
+	post, err := s.repo.GetPostById(postId)
+	if err != nil || post.DeletedAt != nil {
+		return nil, errors.ErrPostNotFound
+	}
+
+	return post, nil
+}
+
+func (s *CommentsService) getPostComment(postId, commentId string) (*entities.Comment, error) {
+	if _, err := uuid.Parse(commentId); err != nil {
+		return nil, errors.ErrCommentNotFound
+	}
+
+	comment, err := s.repo.GetCommentById(commentId)
+	if err != nil {
+		return nil, err
+	}
+	if comment.PostId != postId || comment.DeletedAt != nil {
+		return nil, errors.ErrCommentNotFound
+	}
+
+	return comment, nil
+}
+
+// commentStrictness orders comment statuses from the most to the least
+// visible one.
+var commentStrictness = map[string]int{
+	consts.ApprovedCommentState: 0,
+	consts.PendingCommentState:  1,
+	consts.RejectedCommentState: 2,
+}
+
+// editedCommentVerdict keeps an edit from lifting moderation: a comment held
+// for review or rejected keeps its status unless the checker finds the new
+// content even worse.
+func editedCommentVerdict(comment *entities.Comment, verdict *moderation.Verdict) *moderation.Verdict {
+	if commentStrictness[verdict.Status] > commentStrictness[comment.Status] {
+		return verdict
+	}
+	if comment.Status != consts.ApprovedCommentState {
+		return &moderation.Verdict{Status: comment.Status, Reason: comment.SpamReason}
+	}
+	return verdict
+}
+
+func commentMessage(status, message string) string {
+	switch status {
+	case consts.PendingCommentState:
+		return "comment is awaiting moderation"
+	case consts.RejectedCommentState:
+		return "comment was rejected as spam"
+	default:
+		return message
+	}
+}
+
+func normalizeComment(content string) (string, error) {
+	content = strings.TrimSpace(content)
+	if content == "" || utf8.RuneCountInString(content) > consts.MaxCommentLength {
+		return "", errors.ErrInvalidComment
+	}
+	return content, nil
+}
+
+// limitThreadsReplies keeps the first consts.MaxThreadReplies replies of every
+// thread and gives the threads with more replies a cursor to load the rest.
+func limitThreadsReplies(threads, replies []*entities.Comment) []*entities.Comment {
+	threadOf := make(map[string]*entities.Comment, len(threads)+len(replies))
+	for _, thread := range threads {
+		threadOf[thread.CommentId] = thread
+	}
+
+	counts := make(map[string]int, len(threads))
+	lastReplies := make(map[string]*entities.Comment, len(threads))
+	limited := make([]*entities.Comment, 0, len(replies))
+	for _, reply := range replies {
+		if reply.ParentId == nil {
+			continue
+		}
+		thread, ok := threadOf[*reply.ParentId]
+		if !ok {
+			continue
+		}
+		threadOf[reply.CommentId] = thread
+
+		if counts[thread.CommentId] == consts.MaxThreadReplies {
+			last := lastReplies[thread.CommentId]
+			thread.RepliesCursor = cursor.Encode(repliesCursorKey, last.CreatedAt, last.CommentId)
+			continue
+		}
+		counts[thread.CommentId]++
+		lastReplies[thread.CommentId] = reply
+		limited = append(limited, reply)
+	}
+
+	return limited
+}
+
+// publicComment hides the author and the content of a deleted comment that is
+// kept for its replies.
+func publicComment(comment *entities.Comment) entities.Comment {
+	public := *comment
+	if public.DeletedAt != nil {
+		public.AuthorId = ""
+		public.Content = consts.DeletedCommentContent
+	}
+	return public
+}
+
+// buildCommentsTree nests replies under their parents, keeping the
+// chronological order in which they were loaded. Deleted comments that are
+// kept for their replies are shown as placeholders.
+func buildCommentsTree(threads, replies []*entities.Comment) []entities.Comment {
+	children := make(map[string][]*entities.Comment, len(replies))
+	for _, reply := range replies {
+		if reply.ParentId != nil {
+			children[*reply.ParentId] = append(children[*reply.ParentId], reply)
+		}
+	}
+
+	var build func(comment *entities.Comment) entities.Comment
+	build = func(comment *entities.Comment) entities.Comment {
+		node := publicComment(comment)
+		node.Replies = []entities.Comment{}
+		for _, child := range children[comment.CommentId] {
+			node.Replies = append(node.Replies, build(child))
+		}
+		return node
+	}
+
+	comments := make([]entities.Comment, 0, len(threads))
+	for _, thread := range threads {
+		comments = append(comments, build(thread))
+	}
+
+	return comments
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/internal/moderation"
+	"blog/pkg/consts"
+	"blog/pkg/utils/cursor"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type commentsRepository struct {
+	CommentsBlogRepository
+	post    *entities.Post
+	comment *entities.Comment
+}
+
+func (r *commentsRepository) GetPostById(postId string) (*entities.Post, error) {
+	return r.post, nil
+}
+
+func (r *commentsRepository) GetCommentById(commentId string) (*entities.Comment, error) {
+	comment := *r.comment
+	return &comment, nil
+}
+
+func (r *commentsRepository) EditComment(commentId, content, status, spamReason string, updatedAt time.Time) (*entities.Comment, error) {
+	r.comment.Content, r.comment.Status, r.comment.SpamReason = content, status, spamReason
+	return r.comment, nil
+}
+
+type staticChecker struct {
+	verdict moderation.Verdict
+}
+
+func (c *staticChecker) Check(comment *entities.Comment) (*moderation.Verdict, error) {
+	verdict := c.verdict
+	return &verdict, nil
+}
+
+func TestCommentsService_EditComment_KeepsModeration(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         string
+		reason         string
+		verdict        moderation.Verdict
+		expectedStatus string
+		expectedReason string
+	}{
+		{
+			name:           "rejected stays rejected",
+			status:         consts.RejectedCommentState,
+			reason:         "blocked word: casino",
+			verdict:        moderation.Verdict{Status: consts.ApprovedCommentState},
+			expectedStatus: consts.RejectedCommentState,
+			expectedReason: "blocked word: casino",
+		},
+		{
+			name:           "pending stays pending",
+			status:         consts.PendingCommentState,
+			reason:         "too many links",
+			verdict:        moderation.Verdict{Status: consts.ApprovedCommentState},
+			expectedStatus: consts.PendingCommentState,
+			expectedReason: "too many links",
+		},
+		{
+			name:           "pending becomes rejected",
+			status:         consts.PendingCommentState,
+			reason:         "too many links",
+			verdict:        moderation.Verdict{Status: consts.RejectedCommentState, Reason: "duplicate content"},
+			expectedStatus: consts.RejectedCommentState,
+			expectedReason: "duplicate content",
+		},
+		{
+			name:           "approved follows the checker",
+			status:         consts.ApprovedCommentState,
+			verdict:        moderation.Verdict{Status: consts.PendingCommentState, Reason: "too many links"},
+			expectedStatus: consts.PendingCommentState,
+			expectedReason: "too many links",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			post := &entities.Post{PostId: uuid.NewString(), Status: consts.PublishedState}
+			repo := &commentsRepository{
+				post: post,
+				comment: &entities.Comment{
+					CommentId:  uuid.NewString(),
+					PostId:     post.PostId,
+					AuthorId:   "authorId",
+					Content:    "content",
+					Status:     test.status,
+					SpamReason: test.reason,
+				},
+			}
+			service := NewCommentsService(repo, &staticChecker{verdict: test.verdict})
+
+			_, err := service.EditComment(&dto.EditCommentRequest{
+				PostId:    post.PostId,
+				CommentId: repo.comment.CommentId,
+				AuthorId:  "authorId",
+				Content:   "edited",
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, "edited", repo.comment.Content)
+			assert.Equal(t, test.expectedStatus, repo.comment.Status)
+			assert.Equal(t, test.expectedReason, repo.comment.SpamReason)
+		})
+	}
+}
+
+func TestBuildCommentsTree_DeletedPlaceholder(t *testing.T) {
+	deletedAt := time.Now()
+	thread := &entities.Comment{CommentId: "thread", AuthorId: "authorId", DeletedAt: &deletedAt}
+	reply := &entities.Comment{CommentId: "reply", AuthorId: "replierId", ParentId: &thread.CommentId, Content: "reply"}
+
+	comments := buildCommentsTree([]*entities.Comment{thread}, []*entities.Comment{reply})
+	assert.Len(t, comments, 1)
+	assert.Equal(t, consts.DeletedCommentContent, comments[0].Content)
+	assert.Empty(t, comments[0].AuthorId)
+	assert.Len(t, comments[0].Replies, 1)
+	assert.Equal(t, "reply", comments[0].Replies[0].Content)
+	assert.Equal(t, "replierId", comments[0].Replies[0].AuthorId)
+}
+
+func TestLimitThreadsReplies(t *testing.T) {
+	createdAt := time.Now()
+	full := &entities.Comment{CommentId: "full"}
+	short := &entities.Comment{CommentId: "short"}
+
+	var replies []*entities.Comment
+	parent := full
+	for i := 0; i <= consts.MaxThreadReplies; i++ {
+		reply := &entities.Comment{CommentId: uuid.NewString(), ParentId: &parent.CommentId, CreatedAt: createdAt.Add(time.Duration(i) * time.Second)}
+		replies = append(replies, reply)
+		parent = reply
+	}
+	replies = append(replies, &entities.Comment{CommentId: uuid.NewString(), ParentId: &short.CommentId})
+
+	limited := limitThreadsReplies([]*entities.Comment{full, short}, replies)
+	assert.Len(t, limited, consts.MaxThreadReplies+1)
+	assert.NotEmpty(t, full.RepliesCursor)
+	assert.Empty(t, short.RepliesCursor)
+
+	_, lastId, err := cursor.Decode(full.RepliesCursor, repliesCursorKey)
+	assert.NoError(t, err)
+	assert.Equal(t, replies[consts.MaxThreadReplies-1].CommentId, lastId)
+}
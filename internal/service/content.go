@@ -0,0 +1,24 @@
+package service
+
+import (
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"blog/pkg/utils/markdown"
+	"blog/pkg/utils/sanitize"
+	"html"
+	"strings"
+)
+
+func renderContent(content, contentFormat string) (string, error) {
+	switch contentFormat {
+	case consts.PlainContentFormat:
+		if content == "" {
+			return "", nil
+		}
+		return "<p>" + strings.ReplaceAll(html.EscapeString(content), "\n", "<br>\n") + "</p>", nil
+	case consts.MarkdownContentFormat:
+		return sanitize.HTML(markdown.Render(content)), nil
+	default:
+		return "", errors.ErrInvalidContentFormat
+	}
+}
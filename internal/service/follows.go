@@ -0,0 +1,151 @@
+package service
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"blog/pkg/utils/cursor"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	followersCursorKey = "followers"
+	followingCursorKey = "following"
+)
+
+type FollowsBlogRepository interface {
+	GetAuthorById(authorId string) (*entities.Author, error)
+	GetAllPosts(filter *dto.PostsFilter) ([]*entities.Post, error)
+	Follow(followerId, authorId string, createdAt time.Time) error
+	Unfollow(followerId, authorId string) error
+	GetFollowers(authorId string, cursorValue *time.Time, cursorUserId string, limit int) ([]*entities.Follow, error)
+	GetFollowing(userId string, cursorValue *time.Time, cursorUserId string, limit int) ([]*entities.Follow, error)
+}
+
+type FollowsService struct {
+	repo FollowsBlogRepository
+}
+
+func NewFollowsService(repo FollowsBlogRepository) *FollowsService {
+	return &FollowsService{
+		repo: repo,
+	}
+}
+
+func (s *FollowsService) FollowAuthor(rows *dto.FollowAuthorRequest) (*dto.FollowAuthorResponse, error) {
+	author, err := s.getAuthor(rows.AuthorId)
+	if err != nil {
+		return nil, err
+	}
+	if author.AuthorId == rows.UserId {
+		return nil, errors.ErrCannotFollowYourself
+	}
+
+	if err = s.repo.Follow(rows.UserId, author.AuthorId, time.Now()); err != nil {
+		return nil, err
+	}
+
+	response := &dto.FollowAuthorResponse{
+		Message: "author followed successfully",
+	}
+
+	return response, nil
+}
+
+func (s *FollowsService) UnfollowAuthor(rows *dto.UnfollowAuthorRequest) (*dto.UnfollowAuthorResponse, error) {
+	if _, err := uuid.Parse(rows.AuthorId); err != nil {
+		return nil, errors.ErrFollowNotFound
+	}
+
+	if err := s.repo.Unfollow(rows.UserId, rows.AuthorId); err != nil {
+		return nil, err
+	}
+
+	response := &dto.UnfollowAuthorResponse{
+		Message: "author unfollowed successfully",
+	}
+
+	return response, nil
+}
+
+func (s *FollowsService) ViewFollowers(rows *dto.GetFollowsRequest) (*dto.GetFollowsResponse, error) {
+	return s.viewFollows(rows, followersCursorKey, s.repo.GetFollowers)
+}
+
+func (s *FollowsService) ViewFollowing(rows *dto.GetFollowsRequest) (*dto.GetFollowsResponse, error) {
+	return s.viewFollows(rows, followingCursorKey, s.repo.GetFollowing)
+}
+
+// ViewFeed returns published posts of the authors the user follows, newest
+// first, with the same visibility rules as the public posts list.
+func (s *FollowsService) ViewFeed(rows *dto.GetFeedRequest) (*dto.GetPostsResponse, error) {
+	filter, err := newPostsFilter(&dto.PostsQuery{Limit: rows.Limit, Cursor: rows.Cursor})
+	if err != nil {
+		return nil, err
+	}
+	filter.FollowerId = rows.UserId
+
+	posts, err := s.repo.GetAllPosts(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPostsPage(posts, filter), nil
+}
+
+type followsQuery func(userId string, cursorValue *time.Time, cursorUserId string, limit int) ([]*entities.Follow, error)
+
+func (s *FollowsService) viewFollows(rows *dto.GetFollowsRequest, key string, query followsQuery) (*dto.GetFollowsResponse, error) {
+	author, err := s.getAuthor(rows.AuthorId)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := rows.Limit
+	if limit == 0 {
+		limit = consts.DefaultFollowsLimit
+	}
+	if limit < 0 || limit > consts.MaxFollowsLimit {
+		return nil, errors.ErrInvalidQueryParams
+	}
+
+	var cursorValue *time.Time
+	var cursorUserId string
+	if rows.Cursor != "" {
+		value, userId, err := cursor.Decode(rows.Cursor, key)
+		if err != nil {
+			return nil, errors.ErrInvalidCursor
+		}
+		cursorValue, cursorUserId = &value, userId
+	}
+
+	follows, err := query(author.AuthorId, cursorValue, cursorUserId, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.GetFollowsResponse{
+		Users: make([]entities.Follow, 0, len(follows)),
+	}
+	if len(follows) > limit {
+		follows = follows[:limit]
+		last := follows[len(follows)-1]
+		response.NextCursor = cursor.Encode(key, last.FollowedAt, last.UserId)
+	}
+	for _, follow := range follows {
+		response.Users = append(response.Users, *follow)
+	}
+
+	return response, nil
+}
+
+func (s *FollowsService) getAuthor(authorId string) (*entities.Author, error) {
+	if _, err := uuid.Parse(authorId); err != nil {
+		return nil, errors.ErrAuthorNotFound
+	}
+
+	return s.repo.GetAuthorById(authorId)
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"blog/pkg/utils/cursor"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const moderationCursorKey = "moderation"
+
+type ModerationBlogRepository interface {
+	GetModerationQueue(postAuthorId, status string, cursorValue *time.Time, cursorCommentId string, limit int) ([]*entities.Comment, error)
+	SetCommentsStatus(postAuthorId string, commentIds []string, status string) (int, error)
+}
+
+type ModerationService struct {
+	repo ModerationBlogRepository
+}
+
+func NewModerationService(repo ModerationBlogRepository) *ModerationService {
+	return &ModerationService{
+		repo: repo,
+	}
+}
+
+func (s *ModerationService) ViewModerationQueue(rows *dto.GetModerationQueueRequest) (*dto.GetModerationQueueResponse, error) {
+	status := rows.Status
+	if status == "" {
+		status = consts.PendingCommentState
+	}
+	if !isValidCommentStatus(status) {
+		return nil, errors.ErrInvalidQueryParams
+	}
+
+	limit := rows.Limit
+	if limit == 0 {
+		limit = consts.DefaultCommentsLimit
+	}
+	if limit < 0 || limit > consts.MaxCommentsLimit {
+		return nil, errors.ErrInvalidQueryParams
+	}
+
+	var cursorValue *time.Time
+	var cursorCommentId string
+	if rows.Cursor != "" {
+		value, commentId, err := cursor.Decode(rows.Cursor, moderationCursorKey+":"+status)
+		if err != nil {
+			return nil, errors.ErrInvalidCursor
+		}
+		cursorValue, cursorCommentId = &value, commentId
+	}
+
+	comments, err := s.repo.GetModerationQueue(rows.AuthorId, status, cursorValue, cursorCommentId, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.GetModerationQueueResponse{
+		Comments: make([]entities.Comment, 0, len(comments)),
+	}
+	if len(comments) > limit {
+		comments = comments[:limit]
+		last := comments[len(comments)-1]
+		response.NextCursor = cursor.Encode(moderationCursorKey+":"+status, last.CreatedAt, last.CommentId)
+	}
+	for _, comment := range comments {
+		response.Comments = append(response.Comments, *comment)
+	}
+
+	return response, nil
+}
+
+func (s *ModerationService) ModerateComment(rows *dto.ModerateCommentRequest) (*dto.ModerateCommentResponse, error) {
+	if rows.Status != consts.ApprovedCommentState && rows.Status != consts.RejectedCommentState {
+		return nil, errors.ErrInvalidCommentStatus
+	}
+	if _, err := uuid.Parse(rows.CommentId); err != nil {
+		return nil, errors.ErrCommentNotFound
+	}
+
+	moderated, err := s.repo.SetCommentsStatus(rows.AuthorId, []string{rows.CommentId}, rows.Status)
+	if err != nil {
+		return nil, err
+	}
+	if moderated == 0 {
+		return nil, errors.ErrCommentNotFound
+	}
+
+	response := &dto.ModerateCommentResponse{
+		Message: fmt.Sprintf("comment status changed to %s", rows.Status),
+	}
+
+	return response, nil
+}
+
+func (s *ModerationService) ApproveComments(rows *dto.ApproveCommentsRequest) (*dto.ApproveCommentsResponse, error) {
+	if len(rows.CommentIds) == 0 || len(rows.CommentIds) > consts.MaxModeratedComments {
+		return nil, errors.ErrInvalidCommentIds
+	}
+	for _, commentId := range rows.CommentIds {
+		if _, err := uuid.Parse(commentId); err != nil {
+			return nil, errors.ErrInvalidCommentIds
+		}
+	}
+
+	approved, err := s.repo.SetCommentsStatus(rows.AuthorId, rows.CommentIds, consts.ApprovedCommentState)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ApproveCommentsResponse{
+		Message:  "comments approved successfully",
+		Approved: approved,
+	}
+
+	return response, nil
+}
+
+func isValidCommentStatus(status string) bool {
+	switch status {
+	case consts.PendingCommentState, consts.ApprovedCommentState, consts.RejectedCommentState:
+		return true
+	default:
+		return false
+	}
+}
@@ -5,20 +5,41 @@ import (
 	"blog/internal/models/entities"
 	"blog/pkg/consts"
 	"blog/pkg/consts/errors"
+	"blog/pkg/utils/cursor"
+	"blog/pkg/utils/slug"
 	"context"
 	"fmt"
 	"io"
+	"strings"
 	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
 )
 
 type PostsBlogRepository interface {
-	CreatePost(authorId, idempotencyKey, title, content, status string, createdAt, updatedAt time.Time) (*entities.Post, error)
+	CreatePost(authorId, idempotencyKey, slug, title, content, contentFormat, contentHTML, status, language string, tags []entities.Tag, createdAt, updatedAt time.Time) (*entities.Post, error)
 	GetUserById(userId string) (*entities.User, error)
 	GetPostById(postId string) (*entities.Post, error)
-	EditPost(postId, authorId, idempotencyKey, title, content, status string, createdAt, updatedAt time.Time) (*entities.Post, error)
-
-	GetPostsByUserId(userId string) ([]*entities.Post, error)
-	GetAllPosts() ([]*entities.Post, error)
+	GetPostWithRelationsById(postId string) (*entities.Post, error)
+	EditPost(postId, authorId, idempotencyKey, slug, title, content, contentFormat, contentHTML, status, language string, tags []entities.Tag, version int, createdAt, updatedAt time.Time) (*entities.Post, error)
+	GetPostIdBySlug(slug string) (postId string, redirect bool, err error)
+	TrashPost(postId string, deletedAt time.Time) error
+	RestorePost(postId string) error
+	DeletePost(postId string) error
+
+	GetPostsByUserId(userId string, filter *dto.PostsFilter) ([]*entities.Post, error)
+	GetAllPosts(filter *dto.PostsFilter) ([]*entities.Post, error)
+	GetTrashedPostsByUserId(userId string) ([]*entities.Post, error)
+	GetTrashedPostsBefore(before time.Time) ([]*entities.Post, error)
+	SearchPosts(text, language string, limit, offset int) ([]*entities.SearchResult, error)
+
+	TransitPostStatus(postId, changedBy, from, to string, version int, publishAt *time.Time, changedAt time.Time) (*entities.Post, error)
+	PublishDuePosts(now time.Time, limit int) (int, error)
+	GetPostStatusHistory(postId string) ([]*entities.StatusTransition, error)
+
+	GetPostRevisions(postId string) ([]*entities.Revision, error)
+	GetPostRevisionById(revisionId string) (*entities.Revision, error)
 
 	AddImage(postId, imageURL string, createdAt time.Time) (*entities.Image, error)
 	SetImageURLById(imageId, URL string) error
@@ -30,24 +51,54 @@ type MinioRepository interface {
 	Upload(ctx context.Context, bucket, filename string, file io.Reader, size int64) (string, error)
 	GenerateURL(ctx context.Context, bucket, filename string, expires time.Duration) (string, error)
 	DeleteImage(ctx context.Context, bucket, filename string) error
+	DeleteFolder(ctx context.Context, bucket, prefix string) error
 }
 
 type PostsService struct {
-	repo   PostsBlogRepository
-	minio  MinioRepository
-	bucket string
+	repo     PostsBlogRepository
+	minio    MinioRepository
+	bucket   string
+	language string
 }
 
-func NewPostsService(repo PostsBlogRepository, minio MinioRepository, bucket string) *PostsService {
+func NewPostsService(repo PostsBlogRepository, minio MinioRepository, bucket, language string) *PostsService {
 	return &PostsService{
-		repo:   repo,
-		minio:  minio,
-		bucket: bucket,
+		repo:     repo,
+		minio:    minio,
+		bucket:   bucket,
+		language: language,
 	}
 }
 
 func (s *PostsService) CreatePost(post *dto.CreatePostRequest) (*dto.CreatePostResponse, error) {
-	newPost, err := s.repo.CreatePost(post.AuthorId, post.IdempotencyKey, post.Title, post.Content, consts.DraftState, time.Now(), time.Now())
+	language := post.Language
+	if language == "" {
+		language = s.language
+	}
+	if !isValidLanguage(language) {
+		return nil, errors.ErrInvalidLanguage
+	}
+
+	contentFormat := post.ContentFormat
+	if contentFormat == "" {
+		contentFormat = consts.PlainContentFormat
+	}
+	contentHTML, err := renderContent(post.Content, contentFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := normalizeTags(post.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	postSlug, err := s.makePostSlug(post.Title, "")
+	if err != nil {
+		return nil, err
+	}
+
+	newPost, err := s.repo.CreatePost(post.AuthorId, post.IdempotencyKey, postSlug, post.Title, post.Content, contentFormat, contentHTML, consts.DraftState, language, tags, time.Now(), time.Now())
 	if err != nil {
 		return nil, err
 	}
@@ -65,16 +116,52 @@ func (s *PostsService) CreatePost(post *dto.CreatePostRequest) (*dto.CreatePostR
 }
 
 func (s *PostsService) EditPost(rows *dto.EditPostRequest) (*dto.EditPostResponse, error) {
-	post, err := s.repo.GetPostById(rows.PostId)
+	post, err := s.getActivePost(rows.PostId)
 	if err != nil {
-		return nil, errors.ErrPostNotFound
+		return nil, err
 	}
 
 	if post.AuthorId != rows.AuthorId {
 		return nil, errors.ErrInvalidUser
 	}
+	if rows.Version != consts.AnyPostVersion && post.Version != rows.Version {
+		return nil, errors.ErrPostVersionMismatch
+	}
+
+	language := post.Language
+	if rows.Language != "" {
+		language = rows.Language
+	}
+	if !isValidLanguage(language) {
+		return nil, errors.ErrInvalidLanguage
+	}
+
+	contentFormat := post.ContentFormat
+	if rows.ContentFormat != "" {
+		contentFormat = rows.ContentFormat
+	}
+	contentHTML, err := renderContent(rows.Content, contentFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []entities.Tag
+	if rows.Tags != nil {
+		tags, err = normalizeTags(rows.Tags)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	newPost, err := s.repo.EditPost(post.PostId, post.AuthorId, post.IdempotencyKey, rows.Title, rows.Content, post.Status, post.CreatedAt, time.Now())
+	postSlug := post.Slug
+	if rows.Title != post.Title {
+		postSlug, err = s.makePostSlug(rows.Title, post.PostId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	newPost, err := s.repo.EditPost(post.PostId, post.AuthorId, post.IdempotencyKey, postSlug, rows.Title, rows.Content, contentFormat, contentHTML, post.Status, language, tags, post.Version, post.CreatedAt, time.Now())
 	if err != nil {
 		return nil, err
 	}
@@ -86,43 +173,107 @@ func (s *PostsService) EditPost(rows *dto.EditPostRequest) (*dto.EditPostRespons
 
 	response := &dto.EditPostResponse{
 		Message: message,
+		Version: newPost.Version,
 	}
 
 	return response, nil
 }
 
 func (s *PostsService) PublishPost(rows *dto.PublishPostRequest) (*dto.PublishPostResponse, error) {
-	if rows.Status != consts.PublishedState {
+	if !isValidPostStatus(rows.Status) {
 		return nil, errors.ErrInvalidPostStatus
 	}
+	if !rows.EmailVerified && (rows.Status == consts.PublishedState || rows.Status == consts.ScheduledState) {
+		return nil, errors.ErrEmailNotVerified
+	}
 
-	post, err := s.repo.GetPostById(rows.PostId)
+	now := time.Now()
+	if rows.Status == consts.ScheduledState {
+		if rows.PublishAt == nil || !rows.PublishAt.After(now) {
+			return nil, errors.ErrInvalidPublishAt
+		}
+	} else if rows.PublishAt != nil {
+		return nil, errors.ErrInvalidPublishAt
+	}
+
+	post, err := s.getActivePost(rows.PostId)
 	if err != nil {
-		return nil, errors.ErrPostNotFound
+		return nil, err
 	}
 	if post.AuthorId != rows.AuthorId {
 		return nil, errors.ErrInvalidUser
 	}
+	if rows.Version != consts.AnyPostVersion && post.Version != rows.Version {
+		return nil, errors.ErrPostVersionMismatch
+	}
+	if !canTransitPostStatus(post.Status, rows.Status) {
+		return nil, errors.ErrInvalidStatusTransition
+	}
 
-	newPost, err := s.repo.EditPost(post.PostId, post.AuthorId, post.IdempotencyKey, post.Title, post.Content, rows.Status, post.CreatedAt, time.Now())
+	newPost, err := s.repo.TransitPostStatus(post.PostId, rows.AuthorId, post.Status, rows.Status, post.Version, rows.PublishAt, now)
 	if err != nil {
 		return nil, err
 	}
 
 	var message string
 	if newPost != nil {
-		message = "post published successfully"
+		message = fmt.Sprintf("post status changed to %s", newPost.Status)
 	}
 
 	response := &dto.PublishPostResponse{
 		Message: message,
+		Version: newPost.Version,
 	}
 
 	return response, nil
 }
 
-func (s *PostsService) ViewPostsById(rows *dto.GetPostsByIdRequest) (*dto.GetPostsResponse, error) {
-	posts, err := s.repo.GetPostsByUserId(rows.AuthorId)
+func (s *PostsService) DeletePost(rows *dto.DeletePostRequest) (*dto.DeletePostResponse, error) {
+	post, err := s.getActivePost(rows.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	if post.AuthorId != rows.AuthorId {
+		return nil, errors.ErrNoPermission
+	}
+
+	err = s.repo.TrashPost(post.PostId, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.DeletePostResponse{
+		Message: "post moved to trash",
+	}
+
+	return response, nil
+}
+
+func (s *PostsService) RestorePost(rows *dto.RestorePostRequest) (*dto.RestorePostResponse, error) {
+	post, err := s.repo.GetPostById(rows.PostId)
+	if err != nil || post.DeletedAt == nil {
+		return nil, errors.ErrPostNotFound
+	}
+
+	if post.AuthorId != rows.AuthorId {
+		return nil, errors.ErrNoPermission
+	}
+
+	err = s.repo.RestorePost(post.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.RestorePostResponse{
+		Message: "post restored successfully",
+	}
+
+	return response, nil
+}
+
+func (s *PostsService) ViewTrash(rows *dto.GetTrashRequest) (*dto.GetPostsResponse, error) {
+	posts, err := s.repo.GetTrashedPostsByUserId(rows.AuthorId)
 	if err != nil {
 		return nil, err
 	}
@@ -135,27 +286,264 @@ func (s *PostsService) ViewPostsById(rows *dto.GetPostsByIdRequest) (*dto.GetPos
 	return response, nil
 }
 
-func (s *PostsService) ViewAllPosts() (*dto.GetPostsResponse, error) {
-	posts, err := s.repo.GetAllPosts()
+func (s *PostsService) PurgeTrash(before time.Time) (int, error) {
+	posts, err := s.repo.GetTrashedPostsBefore(before)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, post := range posts {
+		if err = s.deletePostPermanently(post); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+
+	return purged, nil
+}
+
+func (s *PostsService) deletePostPermanently(post *entities.Post) error {
+	minioCtx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	err := s.minio.DeleteFolder(minioCtx, s.bucket, fmt.Sprintf("%s/", post.PostId))
+	if err != nil {
+		return err
+	}
+
+	return s.repo.DeletePost(post.PostId)
+}
+
+func (s *PostsService) getActivePost(postId string) (*entities.Post, error) {
+	post, err := s.repo.GetPostById(postId)
+	if err != nil || post.DeletedAt != nil {
+		return nil, errors.ErrPostNotFound
+	}
+	return post, nil
+}
+
+func (s *PostsService) ViewPost(rows *dto.GetPostRequest) (*dto.GetPostResponse, error) {
+	post, redirect, err := s.findPost(rows.PostIdOrSlug)
+	if err != nil || post.DeletedAt != nil {
+		return nil, errors.ErrPostNotFound
+	}
+
+	if !isReadablePostStatus(post.Status) && post.AuthorId != rows.UserId {
+		return nil, errors.ErrPostNotFound
+	}
+
+	response := &dto.GetPostResponse{
+		Post: *post,
+	}
+	if redirect {
+		response.Redirect = post.Slug
+	}
+
+	return response, nil
+}
+
+func (s *PostsService) ViewPostsById(rows *dto.GetPostsByIdRequest) (*dto.GetPostsResponse, error) {
+	filter, err := newPostsFilter(&rows.PostsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	if rows.Status != "" && !isValidPostStatus(rows.Status) {
+		return nil, errors.ErrInvalidQueryParams
+	}
+	filter.Status = rows.Status
+
+	posts, err := s.repo.GetPostsByUserId(rows.AuthorId, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPostsPage(posts, filter), nil
+}
+
+func (s *PostsService) ViewAllPosts(rows *dto.GetAllPostsRequest) (*dto.GetPostsResponse, error) {
+	filter, err := newPostsFilter(&rows.PostsQuery)
 	if err != nil {
 		return nil, err
 	}
 
+	if rows.Status != "" && rows.Status != consts.PublishedState {
+		return nil, errors.ErrInvalidQueryParams
+	}
+	filter.AuthorId = rows.Author
+
+	posts, err := s.repo.GetAllPosts(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPostsPage(posts, filter), nil
+}
+
+func (s *PostsService) SearchPosts(rows *dto.SearchPostsRequest) (*dto.SearchPostsResponse, error) {
+	text := strings.TrimSpace(rows.Query)
+	if text == "" || utf8.RuneCountInString(text) > consts.MaxSearchQueryLength {
+		return nil, errors.ErrInvalidQueryParams
+	}
+
+	language := rows.Language
+	if language == "" {
+		language = s.language
+	}
+	if !isValidLanguage(language) {
+		return nil, errors.ErrInvalidLanguage
+	}
+
+	limit := rows.Limit
+	if limit == 0 {
+		limit = consts.DefaultPostsLimit
+	}
+	if limit < 0 || limit > consts.MaxPostsLimit || rows.Offset < 0 {
+		return nil, errors.ErrInvalidQueryParams
+	}
+
+	results, err := s.repo.SearchPosts(text, language, limit+1, rows.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.SearchPostsResponse{}
+	if len(results) > limit {
+		results = results[:limit]
+		response.NextOffset = rows.Offset + limit
+	}
+	for _, result := range results {
+		response.Results = append(response.Results, *result)
+	}
+
+	return response, nil
+}
+
+func normalizeTags(names []string) ([]entities.Tag, error) {
+	if len(names) > consts.MaxPostTags {
+		return nil, errors.ErrInvalidTags
+	}
+
+	tags := make([]entities.Tag, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		name = strings.Join(strings.Fields(name), " ")
+		tagSlug := slug.Make(name)
+		if tagSlug == "" || utf8.RuneCountInString(name) > consts.MaxTagNameLength {
+			return nil, errors.ErrInvalidTags
+		}
+		if seen[tagSlug] {
+			continue
+		}
+		seen[tagSlug] = true
+		tags = append(tags, entities.Tag{
+			Slug: tagSlug,
+			Name: name,
+		})
+	}
+
+	return tags, nil
+}
+
+func isValidLanguage(language string) bool {
+	switch language {
+	case consts.SimpleLanguage, consts.EnglishLanguage, consts.RussianLanguage:
+		return true
+	default:
+		return false
+	}
+}
+
+func newPostsFilter(query *dto.PostsQuery) (*dto.PostsFilter, error) {
+	limit := query.Limit
+	if limit == 0 {
+		limit = consts.DefaultPostsLimit
+	}
+	if limit < 0 || limit > consts.MaxPostsLimit {
+		return nil, errors.ErrInvalidQueryParams
+	}
+
+	sortBy := query.Sort
+	if sortBy == "" {
+		sortBy = consts.SortByCreatedAt
+	}
+	if sortBy != consts.SortByCreatedAt && sortBy != consts.SortByUpdatedAt {
+		return nil, errors.ErrInvalidQueryParams
+	}
+
+	order := query.Order
+	if order == "" {
+		order = consts.OrderDesc
+	}
+	if order != consts.OrderAsc && order != consts.OrderDesc {
+		return nil, errors.ErrInvalidQueryParams
+	}
+
+	if query.From != nil && query.To != nil && !query.From.Before(*query.To) {
+		return nil, errors.ErrInvalidQueryParams
+	}
+
+	if query.Author != "" {
+		if _, err := uuid.Parse(query.Author); err != nil {
+			return nil, errors.ErrInvalidQueryParams
+		}
+	}
+
+	filter := &dto.PostsFilter{
+		SortBy:     sortBy,
+		Descending: order == consts.OrderDesc,
+		From:       query.From,
+		To:         query.To,
+		Limit:      limit + 1,
+	}
+
+	if query.Cursor != "" {
+		value, postId, err := cursor.Decode(query.Cursor, cursorKey(filter))
+		if err != nil {
+			return nil, errors.ErrInvalidCursor
+		}
+		filter.CursorValue = &value
+		filter.CursorPostId = postId
+	}
+
+	return filter, nil
+}
+
+func newPostsPage(posts []*entities.Post, filter *dto.PostsFilter) *dto.GetPostsResponse {
 	response := &dto.GetPostsResponse{}
+
+	if pageSize := filter.Limit - 1; len(posts) > pageSize {
+		posts = posts[:pageSize]
+		last := posts[len(posts)-1]
+		value := last.CreatedAt
+		if filter.SortBy == consts.SortByUpdatedAt {
+			value = last.UpdatedAt
+		}
+		response.NextCursor = cursor.Encode(cursorKey(filter), value, last.PostId)
+	}
+
 	for _, post := range posts {
 		response.Posts = append(response.Posts, *post)
 	}
 
-	return response, nil
+	return response
+}
+
+func cursorKey(filter *dto.PostsFilter) string {
+	if filter.Descending {
+		return filter.SortBy + ":" + consts.OrderDesc
+	}
+	return filter.SortBy + ":" + consts.OrderAsc
 }
 
 func (s *PostsService) AddImage(rows *dto.AddImageToPostRequest) (*dto.AddImageToPostResponse, error) {
 	minioCtx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 	defer cancel()
 
-	post, err := s.repo.GetPostById(rows.PostId)
+	post, err := s.getActivePost(rows.PostId)
 	if err != nil {
-		return nil, errors.ErrPostNotFound
+		return nil, err
 	}
 
 	if post.AuthorId != rows.AuthorId {
@@ -200,7 +588,7 @@ func (s *PostsService) DeleteImage(rows *dto.DeleteImageFromPostRequest) (*dto.D
 	minioCtx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 	defer cancel()
 
-	post, err := s.repo.GetPostById(rows.PostId)
+	post, err := s.getActivePost(rows.PostId)
 	if err != nil {
 		return nil, errors.ErrPostOrImageNotFound
 	}
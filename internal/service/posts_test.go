@@ -0,0 +1,53 @@
+package service
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"blog/pkg/utils/cursor"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPostsFilter_Cursor(t *testing.T) {
+	key := cursorKey(&dto.PostsFilter{SortBy: consts.SortByCreatedAt, Descending: true})
+	postId := uuid.NewString()
+
+	filter, err := newPostsFilter(&dto.PostsQuery{Cursor: cursor.Encode(key, time.Now(), postId)})
+	assert.NoError(t, err)
+	assert.Equal(t, postId, filter.CursorPostId)
+
+	_, err = newPostsFilter(&dto.PostsQuery{Cursor: cursor.Encode(key, time.Now(), "not-a-uuid")})
+	assert.ErrorIs(t, err, errors.ErrInvalidCursor)
+}
+
+func TestPostsService_ViewAllPosts_InvalidAuthor(t *testing.T) {
+	service := NewPostsService(nil, nil, "", "")
+
+	_, err := service.ViewAllPosts(&dto.GetAllPostsRequest{
+		PostsQuery: dto.PostsQuery{Author: "not-a-uuid"},
+	})
+	assert.ErrorIs(t, err, errors.ErrInvalidQueryParams)
+}
+
+func TestTagsService_ViewTagPosts_InvalidAuthor(t *testing.T) {
+	service := NewTagsService(&tagsRepository{})
+
+	_, err := service.ViewTagPosts(&dto.GetTagPostsRequest{
+		Slug:       "go",
+		PostsQuery: dto.PostsQuery{Author: "not-a-uuid"},
+	})
+	assert.ErrorIs(t, err, errors.ErrInvalidQueryParams)
+}
+
+type tagsRepository struct {
+	TagsBlogRepository
+}
+
+func (r *tagsRepository) GetTagBySlug(slug string) (*entities.Tag, error) {
+	return &entities.Tag{Slug: slug}, nil
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"blog/pkg/utils/cursor"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const reactionsCursorKey = "reactions"
+
+type ReactionsBlogRepository interface {
+	GetPostById(postId string) (*entities.Post, error)
+	ToggleReaction(postId, userId, reaction string, createdAt time.Time) (bool, error)
+	GetPostReactions(postId string) (map[string]int, error)
+	GetUserReactedPosts(userId string, cursorValue *time.Time, cursorPostId string, limit int) ([]*entities.ReactedPost, error)
+}
+
+type ReactionsService struct {
+	repo ReactionsBlogRepository
+}
+
+func NewReactionsService(repo ReactionsBlogRepository) *ReactionsService {
+	return &ReactionsService{
+		repo: repo,
+	}
+}
+
+func (s *ReactionsService) ToggleReaction(rows *dto.ToggleReactionRequest) (*dto.ToggleReactionResponse, error) {
+	if !isValidReaction(rows.Reaction) {
+		return nil, errors.ErrInvalidReaction
+	}
+
+	if _, err := uuid.Parse(rows.PostId); err != nil {
+		return nil, errors.ErrPostNotFound
+	}
+	post, err := s.repo.GetPostById(rows.PostId)
+	if err != nil || post.DeletedAt != nil || post.Status != consts.PublishedState {
+		return nil, errors.ErrPostNotFound
+	}
+
+	reacted, err := s.repo.ToggleReaction(post.PostId, rows.UserId, rows.Reaction, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	reactions, err := s.repo.GetPostReactions(post.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	message := "reaction removed"
+	if reacted {
+		message = "reaction added"
+	}
+
+	response := &dto.ToggleReactionResponse{
+		Message:   message,
+		Reacted:   reacted,
+		Reactions: reactions,
+	}
+
+	return response, nil
+}
+
+func (s *ReactionsService) ViewReactedPosts(rows *dto.GetReactedPostsRequest) (*dto.GetReactedPostsResponse, error) {
+	limit := rows.Limit
+	if limit == 0 {
+		limit = consts.DefaultReactedPostsLimit
+	}
+	if limit < 0 || limit > consts.MaxReactedPostsLimit {
+		return nil, errors.ErrInvalidQueryParams
+	}
+
+	var cursorValue *time.Time
+	var cursorPostId string
+	if rows.Cursor != "" {
+		value, postId, err := cursor.Decode(rows.Cursor, reactionsCursorKey)
+		if err != nil {
+			return nil, errors.ErrInvalidCursor
+		}
+		cursorValue, cursorPostId = &value, postId
+	}
+
+	posts, err := s.repo.GetUserReactedPosts(rows.UserId, cursorValue, cursorPostId, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.GetReactedPostsResponse{
+		Posts: make([]entities.ReactedPost, 0, len(posts)),
+	}
+	if len(posts) > limit {
+		posts = posts[:limit]
+		last := posts[len(posts)-1]
+		response.NextCursor = cursor.Encode(reactionsCursorKey, last.ReactedAt, last.Post.PostId)
+	}
+	for _, post := range posts {
+		response.Posts = append(response.Posts, *post)
+	}
+
+	return response, nil
+}
+
+func isValidReaction(reaction string) bool {
+	switch reaction {
+	case consts.LikeReaction, consts.HeartReaction, consts.LaughReaction, consts.WowReaction, consts.SadReaction, consts.FireReaction:
+		return true
+	default:
+		return false
+	}
+}
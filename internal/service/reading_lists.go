@@ -0,0 +1,203 @@
+package service
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+type ReadingListsBlogRepository interface {
+	GetPostById(postId string) (*entities.Post, error)
+	CreateReadingList(userId, name string, createdAt time.Time) (*entities.ReadingList, error)
+	GetReadingLists(userId string) ([]*entities.ReadingList, error)
+	GetReadingListById(listId string) (*entities.ReadingList, error)
+	RenameReadingList(listId, name string, updatedAt time.Time) error
+	DeleteReadingList(listId string) error
+	GetReadingListPosts(listId string) ([]*entities.Post, error)
+	AddPostToReadingList(listId, postId string, position *int, addedAt time.Time) error
+	RemovePostFromReadingList(listId, postId string, updatedAt time.Time) error
+}
+
+type ReadingListsService struct {
+	repo ReadingListsBlogRepository
+}
+
+func NewReadingListsService(repo ReadingListsBlogRepository) *ReadingListsService {
+	return &ReadingListsService{
+		repo: repo,
+	}
+}
+
+func (s *ReadingListsService) CreateReadingList(rows *dto.CreateReadingListRequest) (*dto.CreateReadingListResponse, error) {
+	name, err := normalizeReadingListName(rows.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := s.repo.CreateReadingList(rows.UserId, name, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.CreateReadingListResponse{
+		Message:     "reading list created successfully",
+		ReadingList: *list,
+	}
+
+	return response, nil
+}
+
+func (s *ReadingListsService) ViewReadingLists(rows *dto.GetReadingListsRequest) (*dto.GetReadingListsResponse, error) {
+	lists, err := s.repo.GetReadingLists(rows.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.GetReadingListsResponse{
+		ReadingLists: make([]entities.ReadingList, 0, len(lists)),
+	}
+	for _, list := range lists {
+		response.ReadingLists = append(response.ReadingLists, *list)
+	}
+
+	return response, nil
+}
+
+func (s *ReadingListsService) ViewReadingList(rows *dto.GetReadingListRequest) (*dto.GetReadingListResponse, error) {
+	list, err := s.getReadingList(rows.UserId, rows.ListId)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := s.repo.GetReadingListPosts(list.ListId)
+	if err != nil {
+		return nil, err
+	}
+
+	list.Posts = make([]entities.Post, 0, len(posts))
+	for _, post := range posts {
+		list.Posts = append(list.Posts, *post)
+	}
+
+	response := &dto.GetReadingListResponse{
+		ReadingList: *list,
+	}
+
+	return response, nil
+}
+
+func (s *ReadingListsService) RenameReadingList(rows *dto.RenameReadingListRequest) (*dto.RenameReadingListResponse, error) {
+	name, err := normalizeReadingListName(rows.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := s.getReadingList(rows.UserId, rows.ListId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.RenameReadingList(list.ListId, name, time.Now()); err != nil {
+		return nil, err
+	}
+
+	response := &dto.RenameReadingListResponse{
+		Message: "reading list renamed successfully",
+	}
+
+	return response, nil
+}
+
+func (s *ReadingListsService) DeleteReadingList(rows *dto.DeleteReadingListRequest) (*dto.DeleteReadingListResponse, error) {
+	list, err := s.getReadingList(rows.UserId, rows.ListId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.DeleteReadingList(list.ListId); err != nil {
+		return nil, err
+	}
+
+	response := &dto.DeleteReadingListResponse{
+		Message: "reading list deleted successfully",
+	}
+
+	return response, nil
+}
+
+func (s *ReadingListsService) AddToReadingList(rows *dto.AddToReadingListRequest) (*dto.AddToReadingListResponse, error) {
+	if rows.Position != nil && *rows.Position < 1 {
+		return nil, errors.ErrInvalidPosition
+	}
+
+	list, err := s.getReadingList(rows.UserId, rows.ListId)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := getPublishedPost(s.repo, rows.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.AddPostToReadingList(list.ListId, post.PostId, rows.Position, time.Now()); err != nil {
+		return nil, err
+	}
+
+	response := &dto.AddToReadingListResponse{
+		Message: "post added to reading list",
+	}
+
+	return response, nil
+}
+
+func (s *ReadingListsService) RemoveFromReadingList(rows *dto.RemoveFromReadingListRequest) (*dto.RemoveFromReadingListResponse, error) {
+	list, err := s.getReadingList(rows.UserId, rows.ListId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = uuid.Parse(rows.PostId); err != nil {
+		return nil, errors.ErrPostNotFound
+	}
+
+	if err = s.repo.RemovePostFromReadingList(list.ListId, rows.PostId, time.Now()); err != nil {
+		return nil, err
+	}
+
+	response := &dto.RemoveFromReadingListResponse{
+		Message: "post removed from reading list",
+	}
+
+	return response, nil
+}
+
+func (s *ReadingListsService) getReadingList(userId, listId string) (*entities.ReadingList, error) {
+	if _, err := uuid.Parse(listId); err != nil {
+		return nil, errors.ErrReadingListNotFound
+	}
+
+	list, err := s.repo.GetReadingListById(listId)
+	if err != nil {
+		return nil, err
+	}
+	if list.UserId != userId {
+		return nil, errors.ErrReadingListNotFound
+	}
+
+	return list, nil
+}
+
+func normalizeReadingListName(name string) (string, error) {
+	name = strings.Join(strings.Fields(name), " ")
+	if name == "" || utf8.RuneCountInString(name) > consts.MaxReadingListNameLength {
+		return "", errors.ErrInvalidReadingListName
+	}
+	return name, nil
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts/errors"
+	"blog/pkg/utils/diff"
+)
+
+func (s *PostsService) ViewRevisions(rows *dto.GetRevisionsRequest) (*dto.GetRevisionsResponse, error) {
+	post, err := s.getActivePost(rows.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if post.AuthorId != rows.AuthorId {
+		return nil, errors.ErrNoPermission
+	}
+
+	revisions, err := s.repo.GetPostRevisions(post.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.GetRevisionsResponse{}
+	for _, revision := range revisions {
+		response.Revisions = append(response.Revisions, *revision)
+	}
+
+	return response, nil
+}
+
+func (s *PostsService) ViewRevision(rows *dto.GetRevisionRequest) (*dto.GetRevisionResponse, error) {
+	_, revision, err := s.getPostRevision(rows.AuthorId, rows.PostId, rows.RevisionId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.GetRevisionResponse{
+		Revision: *revision,
+	}
+
+	return response, nil
+}
+
+func (s *PostsService) DiffRevisions(rows *dto.DiffRevisionsRequest) (*dto.DiffRevisionsResponse, error) {
+	if rows.From == "" || rows.To == "" {
+		return nil, errors.ErrInvalidQueryParams
+	}
+
+	_, from, err := s.getPostRevision(rows.AuthorId, rows.PostId, rows.From)
+	if err != nil {
+		return nil, err
+	}
+	_, to, err := s.getPostRevision(rows.AuthorId, rows.PostId, rows.To)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.DiffRevisionsResponse{
+		From:    from.RevisionId,
+		To:      to.RevisionId,
+		Title:   diff.Lines(from.Title, to.Title),
+		Content: diff.Lines(from.Content, to.Content),
+	}
+
+	return response, nil
+}
+
+func (s *PostsService) RestoreRevision(rows *dto.RestoreRevisionRequest) (*dto.RestoreRevisionResponse, error) {
+	post, revision, err := s.getPostRevision(rows.AuthorId, rows.PostId, rows.RevisionId)
+	if err != nil {
+		return nil, err
+	}
+
+	edited, err := s.EditPost(&dto.EditPostRequest{
+		AuthorId:      rows.AuthorId,
+		PostId:        post.PostId,
+		Version:       rows.Version,
+		Title:         revision.Title,
+		Content:       revision.Content,
+		ContentFormat: revision.ContentFormat,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.RestoreRevisionResponse{
+		Message: "revision restored successfully",
+		Version: edited.Version,
+	}
+
+	return response, nil
+}
+
+func (s *PostsService) getPostRevision(authorId, postId, revisionId string) (*entities.Post, *entities.Revision, error) {
+	post, err := s.getActivePost(postId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if post.AuthorId != authorId {
+		return nil, nil, errors.ErrNoPermission
+	}
+
+	revision, err := s.repo.GetPostRevisionById(revisionId)
+	if err != nil || revision.PostId != post.PostId {
+		return nil, nil, errors.ErrRevisionNotFound
+	}
+
+	return post, revision, nil
+}
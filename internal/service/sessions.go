@@ -0,0 +1,68 @@
+package service
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts/errors"
+	"blog/pkg/utils/jwt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SessionsBlogRepository interface {
+	GetUserSessions(userId string, now time.Time) ([]*entities.Session, error)
+	RevokeSession(userId, sessionId string, revokedAt time.Time) error
+	PurgeSessions(before time.Time) (int, error)
+}
+
+type SessionsService struct {
+	repo SessionsBlogRepository
+}
+
+func NewSessionsService(repo SessionsBlogRepository) *SessionsService {
+	return &SessionsService{
+		repo: repo,
+	}
+}
+
+func (s *SessionsService) ViewSessions(rows *dto.GetSessionsRequest) (*dto.GetSessionsResponse, error) {
+	sessions, err := s.repo.GetUserSessions(rows.UserId, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.GetSessionsResponse{
+		Sessions: make([]entities.Session, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		session.Current = session.SessionId == rows.CurrentSessionId
+		response.Sessions = append(response.Sessions, *session)
+	}
+
+	return response, nil
+}
+
+func (s *SessionsService) RevokeSession(rows *dto.RevokeSessionRequest) (*dto.RevokeSessionResponse, error) {
+	sessionId, err := uuid.Parse(rows.SessionId)
+	if err != nil {
+		return nil, errors.ErrSessionNotFound
+	}
+
+	if err = s.repo.RevokeSession(rows.UserId, sessionId.String(), time.Now()); err != nil {
+		return nil, err
+	}
+
+	response := &dto.RevokeSessionResponse{
+		Message: "session revoked successfully",
+	}
+
+	return response, nil
+}
+
+// PurgeSessions deletes sessions and rotated refresh tokens that are no longer
+// needed. A refresh token is rejected once its lifetime is over, so after that
+// its row is useful neither for signing in nor for reuse detection.
+func (s *SessionsService) PurgeSessions(now time.Time) (int, error) {
+	return s.repo.PurgeSessions(now.Add(-jwt.RefreshTokenTTL))
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"blog/pkg/utils/sitemap"
+	"fmt"
+	"strings"
+)
+
+type SitemapBlogRepository interface {
+	CountSitemapEntries() (int, error)
+	GetSitemapEntries(limit, offset int) ([]*entities.SitemapEntry, error)
+}
+
+type SitemapService struct {
+	repo     SitemapBlogRepository
+	baseURL  string
+	disallow []string
+}
+
+func NewSitemapService(repo SitemapBlogRepository, baseURL string, disallow []string) *SitemapService {
+	return &SitemapService{
+		repo:     repo,
+		baseURL:  strings.TrimRight(baseURL, "/"),
+		disallow: disallow,
+	}
+}
+
+// ViewSitemap returns the sitemap itself while every URL fits into one file
+// and a sitemap index pointing to the numbered files otherwise.
+func (s *SitemapService) ViewSitemap() (*dto.GetSitemapResponse, error) {
+	count, err := s.repo.CountSitemapEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	pages := sitemap.Pages(count)
+	if pages == 1 {
+		return s.sitemapPage(1)
+	}
+
+	sitemaps := make([]sitemap.URL, 0, pages)
+	for page := 1; page <= pages; page++ {
+		sitemaps = append(sitemaps, sitemap.URL{Loc: s.baseURL + "/sitemaps/" + sitemapFile(page)})
+	}
+
+	body, err := sitemap.Index(sitemaps)
+	if err != nil {
+		return nil, errors.ErrInternalServerError
+	}
+
+	return &dto.GetSitemapResponse{Body: body}, nil
+}
+
+func (s *SitemapService) ViewSitemapFile(rows *dto.GetSitemapRequest) (*dto.GetSitemapResponse, error) {
+	var page int
+	if _, err := fmt.Sscanf(rows.File, "sitemap-%d.xml", &page); err != nil || sitemapFile(page) != rows.File {
+		return nil, errors.ErrSitemapNotFound
+	}
+
+	count, err := s.repo.CountSitemapEntries()
+	if err != nil {
+		return nil, err
+	}
+	if page < 1 || page > sitemap.Pages(count) {
+		return nil, errors.ErrSitemapNotFound
+	}
+
+	return s.sitemapPage(page)
+}
+
+func (s *SitemapService) ViewRobots() *dto.GetRobotsResponse {
+	var builder strings.Builder
+
+	builder.WriteString("User-agent: *\n")
+	if len(s.disallow) == 0 {
+		builder.WriteString("Disallow:\n")
+	}
+	for _, path := range s.disallow {
+		builder.WriteString("Disallow: " + path + "\n")
+	}
+	builder.WriteString("\nSitemap: " + s.baseURL + "/sitemap.xml\n")
+
+	return &dto.GetRobotsResponse{Body: builder.String()}
+}
+
+func (s *SitemapService) sitemapPage(page int) (*dto.GetSitemapResponse, error) {
+	entries, err := s.repo.GetSitemapEntries(sitemap.MaxURLs, (page-1)*sitemap.MaxURLs)
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make([]sitemap.URL, 0, len(entries))
+	for _, entry := range entries {
+		urls = append(urls, sitemap.URL{Loc: s.baseURL + sitemapEntryPath(entry), LastMod: entry.UpdatedAt})
+	}
+
+	body, err := sitemap.URLSet(urls)
+	if err != nil {
+		return nil, errors.ErrInternalServerError
+	}
+
+	return &dto.GetSitemapResponse{Body: body}, nil
+}
+
+func sitemapEntryPath(entry *entities.SitemapEntry) string {
+	switch entry.Kind {
+	case consts.AuthorSitemapEntry:
+		return "/authors/" + entry.Key
+	case consts.TagSitemapEntry:
+		return "/tags/" + entry.Key + "/posts"
+	default:
+		return "/posts/" + entry.Key
+	}
+}
+
+func sitemapFile(page int) string {
+	return fmt.Sprintf("sitemap-%d.xml", page)
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"blog/internal/models/entities"
+	"blog/pkg/consts/errors"
+	"blog/pkg/utils/slug"
+	stderr "errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const defaultPostSlug = "post"
+
+// reservedPostSlugs are path segments that routes under /posts/ already use,
+// so a post with one of these slugs would be unreachable.
+var reservedPostSlugs = map[string]bool{
+	"trash":  true,
+	"search": true,
+}
+
+// makePostSlug derives a slug from the title and appends a counter until it
+// does not clash with a reserved word or a current or previous slug of
+// another post.
+func (s *PostsService) makePostSlug(title, postId string) (string, error) {
+	base := slug.Make(title)
+	if base == "" {
+		base = defaultPostSlug
+	}
+
+	candidate := base
+	for i := 2; ; i++ {
+		if reservedPostSlugs[candidate] {
+			candidate = fmt.Sprintf("%s-%d", base, i)
+			continue
+		}
+		ownerId, _, err := s.repo.GetPostIdBySlug(candidate)
+		if stderr.Is(err, errors.ErrPostNotFound) || (err == nil && ownerId == postId) {
+			return candidate, nil
+		}
+		if err != nil {
+			return "", err
+		}
+		candidate = fmt.Sprintf("%s-%d", base, i)
+	}
+}
+
+func (s *PostsService) findPost(postIdOrSlug string) (*entities.Post, bool, error) {
+	if _, err := uuid.Parse(postIdOrSlug); err == nil {
+		post, err := s.repo.GetPostWithRelationsById(postIdOrSlug)
+		if err == nil {
+			return post, false, nil
+		}
+		if !stderr.Is(err, errors.ErrInvalidPostId) {
+			return nil, false, err
+		}
+	}
+
+	postId, redirect, err := s.repo.GetPostIdBySlug(postIdOrSlug)
+	if err != nil {
+		return nil, false, err
+	}
+
+	post, err := s.repo.GetPostWithRelationsById(postId)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return post, redirect, nil
+}
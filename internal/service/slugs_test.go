@@ -0,0 +1,72 @@
+package service
+
+import (
+	"blog/pkg/consts/errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type slugsRepository struct {
+	PostsBlogRepository
+	owners map[string]string
+}
+
+func (r *slugsRepository) GetPostIdBySlug(slug string) (string, bool, error) {
+	ownerId, ok := r.owners[slug]
+	if !ok {
+		return "", false, errors.ErrPostNotFound
+	}
+	return ownerId, false, nil
+}
+
+func TestPostsService_MakePostSlug(t *testing.T) {
+	tests := []struct {
+		name     string
+		title    string
+		postId   string
+		owners   map[string]string
+		expected string
+	}{
+		{
+			name:     "free",
+			title:    "Hello World",
+			expected: "hello-world",
+		},
+		{
+			name:     "taken by another post",
+			title:    "Hello World",
+			postId:   "postId",
+			owners:   map[string]string{"hello-world": "otherId"},
+			expected: "hello-world-2",
+		},
+		{
+			name:     "owned by the same post",
+			title:    "Hello World",
+			postId:   "postId",
+			owners:   map[string]string{"hello-world": "postId"},
+			expected: "hello-world",
+		},
+		{
+			name:     "reserved trash",
+			title:    "Trash",
+			expected: "trash-2",
+		},
+		{
+			name:     "reserved search",
+			title:    "Search",
+			owners:   map[string]string{"search-2": "otherId"},
+			expected: "search-3",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := NewPostsService(&slugsRepository{owners: test.owners}, nil, "", "")
+
+			slug, err := service.makePostSlug(test.title, test.postId)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, slug)
+		})
+	}
+}
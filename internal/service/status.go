@@ -0,0 +1,67 @@
+package service
+
+import (
+	"blog/internal/models/dto"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"slices"
+	"time"
+)
+
+var postStatusTransitions = map[string][]string{
+	consts.DraftState:     {consts.PublishedState, consts.ScheduledState, consts.ArchivedState},
+	consts.ScheduledState: {consts.DraftState, consts.ScheduledState, consts.PublishedState, consts.ArchivedState},
+	consts.PublishedState: {consts.DraftState, consts.ArchivedState},
+	consts.ArchivedState:  {consts.DraftState, consts.PublishedState},
+}
+
+func isValidPostStatus(status string) bool {
+	_, ok := postStatusTransitions[status]
+	return ok
+}
+
+func canTransitPostStatus(from, to string) bool {
+	return slices.Contains(postStatusTransitions[from], to)
+}
+
+// Archived posts drop out of listings, search and tags but stay readable by
+// a direct link, so already shared URLs keep working.
+func isReadablePostStatus(status string) bool {
+	return status == consts.PublishedState || status == consts.ArchivedState
+}
+
+func (s *PostsService) ViewStatusHistory(rows *dto.GetStatusHistoryRequest) (*dto.GetStatusHistoryResponse, error) {
+	post, err := s.getActivePost(rows.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if post.AuthorId != rows.AuthorId {
+		return nil, errors.ErrNoPermission
+	}
+
+	transitions, err := s.repo.GetPostStatusHistory(post.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.GetStatusHistoryResponse{}
+	for _, transition := range transitions {
+		response.Transitions = append(response.Transitions, *transition)
+	}
+
+	return response, nil
+}
+
+func (s *PostsService) PublishScheduledPosts(now time.Time) (int, error) {
+	total := 0
+	for {
+		published, err := s.repo.PublishDuePosts(now, consts.ScheduledPostsBatchSize)
+		total += published
+		if err != nil {
+			return total, err
+		}
+		if published < consts.ScheduledPostsBatchSize {
+			return total, nil
+		}
+	}
+}
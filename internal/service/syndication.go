@@ -0,0 +1,140 @@
+package service
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"blog/pkg/utils/feed"
+	"blog/pkg/utils/slug"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var feedRenderers = map[string]struct {
+	contentType string
+	render      func(f *feed.Feed) ([]byte, error)
+}{
+	consts.RSSFeedFormat:  {contentType: "application/rss+xml; charset=utf-8", render: feed.RSS},
+	consts.AtomFeedFormat: {contentType: "application/atom+xml; charset=utf-8", render: feed.Atom},
+	consts.JSONFeedFormat: {contentType: "application/feed+json; charset=utf-8", render: feed.JSON},
+}
+
+type SyndicationBlogRepository interface {
+	GetAuthorById(authorId string) (*entities.Author, error)
+	GetTagBySlug(slug string) (*entities.Tag, error)
+	GetAllPosts(filter *dto.PostsFilter) ([]*entities.Post, error)
+}
+
+type SyndicationService struct {
+	repo    SyndicationBlogRepository
+	baseURL string
+	title   string
+}
+
+func NewSyndicationService(repo SyndicationBlogRepository, baseURL, title string) *SyndicationService {
+	return &SyndicationService{
+		repo:    repo,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		title:   title,
+	}
+}
+
+// ViewFeed renders the latest published posts of the whole blog, of an
+// author or of a tag in the requested feed format.
+func (s *SyndicationService) ViewFeed(rows *dto.GetSyndicationFeedRequest) (*dto.GetSyndicationFeedResponse, error) {
+	renderer, ok := feedRenderers[rows.Format]
+	if !ok {
+		return nil, errors.ErrInvalidFeedFormat
+	}
+
+	filter := &dto.PostsFilter{
+		SortBy:     consts.SortByCreatedAt,
+		Descending: true,
+		Limit:      consts.SyndicationFeedSize,
+	}
+	f := &feed.Feed{
+		Title:       s.title,
+		Description: s.title,
+		Link:        s.baseURL + "/posts",
+	}
+	feedPath := ""
+
+	switch {
+	case rows.AuthorId != "":
+		if _, err := uuid.Parse(rows.AuthorId); err != nil {
+			return nil, errors.ErrAuthorNotFound
+		}
+		author, err := s.repo.GetAuthorById(rows.AuthorId)
+		if err != nil {
+			return nil, err
+		}
+		filter.AuthorId = author.AuthorId
+		f.Link = s.authorURL(author.AuthorId)
+		feedPath = "/authors/" + author.AuthorId
+		f.Title += ": " + author.AuthorId
+		f.Description = "Posts by author " + author.AuthorId
+	case rows.Tag != "":
+		tag, err := s.repo.GetTagBySlug(slug.Make(rows.Tag))
+		if err != nil {
+			return nil, err
+		}
+		filter.Tag = tag.Slug
+		f.Link = s.baseURL + "/tags/" + tag.Slug + "/posts"
+		feedPath = "/tags/" + tag.Slug
+		f.Title += ": " + tag.Name
+		f.Description = "Posts tagged " + tag.Name
+	}
+	f.FeedURL = s.baseURL + feedPath + "/feed." + rows.Format
+
+	posts, err := s.repo.GetAllPosts(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	f.Items = make([]feed.Item, 0, len(posts))
+	for _, post := range posts {
+		if post.UpdatedAt.After(f.Updated) {
+			f.Updated = post.UpdatedAt
+		}
+		f.Items = append(f.Items, s.feedItem(post))
+	}
+	// Atom requires the feed to have an update time even with no entries.
+	if f.Updated.IsZero() {
+		f.Updated = time.Now()
+	}
+
+	body, err := renderer.render(f)
+	if err != nil {
+		return nil, errors.ErrInternalServerError
+	}
+
+	response := &dto.GetSyndicationFeedResponse{
+		ContentType: renderer.contentType,
+		Body:        body,
+	}
+
+	return response, nil
+}
+
+func (s *SyndicationService) feedItem(post *entities.Post) feed.Item {
+	item := feed.Item{
+		ID:          "urn:uuid:" + post.PostId,
+		Title:       post.Title,
+		Link:        s.baseURL + "/posts/" + post.Slug,
+		AuthorURL:   s.authorURL(post.AuthorId),
+		ContentHTML: post.ContentHTML,
+		Published:   post.CreatedAt,
+		Updated:     post.UpdatedAt,
+	}
+	for _, tag := range post.Tags {
+		item.Tags = append(item.Tags, tag.Name)
+	}
+	return item
+}
+
+func (s *SyndicationService) authorURL(authorId string) string {
+	return s.baseURL + "/authors/" + authorId
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/utils/slug"
+)
+
+type TagsBlogRepository interface {
+	GetTags() ([]*entities.Tag, error)
+	GetTagBySlug(slug string) (*entities.Tag, error)
+	GetAllPosts(filter *dto.PostsFilter) ([]*entities.Post, error)
+}
+
+type TagsService struct {
+	repo TagsBlogRepository
+}
+
+func NewTagsService(repo TagsBlogRepository) *TagsService {
+	return &TagsService{
+		repo: repo,
+	}
+}
+
+func (s *TagsService) ViewTags() (*dto.GetTagsResponse, error) {
+	tags, err := s.repo.GetTags()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.GetTagsResponse{}
+	for _, tag := range tags {
+		response.Tags = append(response.Tags, *tag)
+	}
+
+	return response, nil
+}
+
+func (s *TagsService) ViewTagPosts(rows *dto.GetTagPostsRequest) (*dto.GetPostsResponse, error) {
+	tag, err := s.repo.GetTagBySlug(slug.Make(rows.Slug))
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := newPostsFilter(&rows.PostsQuery)
+	if err != nil {
+		return nil, err
+	}
+	filter.Tag = tag.Slug
+	filter.AuthorId = rows.Author
+
+	posts, err := s.repo.GetAllPosts(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPostsPage(posts, filter), nil
+}
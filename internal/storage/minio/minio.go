@@ -98,3 +98,37 @@ func (r *MinioClient) DeleteImage(ctx context.Context, bucket, filename string)
 	}
 	return nil
 }
+
+func (r *MinioClient) DeleteFolder(ctx context.Context, bucket, prefix string) error {
+	exists, err := r.Client.BucketExists(ctx, bucket)
+	if err != nil {
+		return errors.ErrMinioBucketNotExists
+	}
+	if !exists {
+		return nil
+	}
+
+	var listErr error
+	objectsCh := make(chan minio.ObjectInfo)
+	go func() {
+		defer close(objectsCh)
+		for object := range r.Client.ListObjects(ctx, bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+			if object.Err != nil {
+				listErr = object.Err
+				return
+			}
+			objectsCh <- object
+		}
+	}()
+
+	var removeErr error
+	for result := range r.Client.RemoveObjects(ctx, bucket, objectsCh, minio.RemoveObjectsOptions{}) {
+		if result.Err != nil && removeErr == nil {
+			removeErr = errors.ErrMinioRemoveObject
+		}
+	}
+	if listErr != nil {
+		return errors.ErrMinioListObjects
+	}
+	return removeErr
+}
@@ -6,6 +6,7 @@ import (
 	"blog/pkg/consts/errors"
 	"encoding/json"
 	stderr "errors"
+	"net"
 	"net/http"
 
 	"go.uber.org/zap"
@@ -15,6 +16,12 @@ type AuthService interface {
 	RegistrateUser(user *dto.RegistrateUserRequest) (*dto.RegistrateUserResponse, error)
 	LoginUser(user *dto.LoginUserRequest) (*dto.LoginUserResponse, error)
 	RefreshUserToken(token *dto.RefreshUserTokenRequest) (*dto.RefreshUserTokenResponse, error)
+	LogoutUser(rows *dto.LogoutUserRequest) (*dto.LogoutUserResponse, error)
+	LogoutUserEverywhere(rows *dto.LogoutUserRequest) (*dto.LogoutUserResponse, error)
+	ForgotPassword(rows *dto.ForgotPasswordRequest) (*dto.ForgotPasswordResponse, error)
+	ResetPassword(rows *dto.ResetPasswordRequest) (*dto.ResetPasswordResponse, error)
+	VerifyEmail(rows *dto.VerifyEmailRequest) (*dto.VerifyEmailResponse, error)
+	ResendVerification(rows *dto.ResendVerificationRequest) (*dto.ResendVerificationResponse, error)
 }
 type AuthController struct {
 	srv AuthService
@@ -28,6 +35,7 @@ func NewAuthController(srv AuthService) *AuthController {
 
 // RegistrateUser godoc
 // @Summary Зарегистрировать пользователя
+// @Description На почту отправляется ссылка для её подтверждения. Если письмо не дошло, его можно запросить повторно через /api/auth/verify-email/resend
 // @Tags Роли пользователей и аутентификация
 // @Accept json
 // @Produce json
@@ -35,6 +43,7 @@ func NewAuthController(srv AuthService) *AuthController {
 // @Success 200 {object} dto.RegistrateUserResponse
 // @Failure 403 {string} errors.ErrUserAlreadyExists "user already exists"
 // @Failure 400 {string} errors.ErrInvalidEmail "invalid email"
+// @Failure 400 {string} errors.ErrDisposableEmail "disposable email addresses are not allowed"
 // @Router /api/auth/register [post]
 func (c *AuthController) RegistrateUser(w http.ResponseWriter, r *http.Request) {
 	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "RegistrateUser"))
@@ -49,13 +58,15 @@ func (c *AuthController) RegistrateUser(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	request.UserAgent, request.IP = r.UserAgent(), clientIP(r)
+
 	response, err := c.srv.RegistrateUser(&request)
 	if err != nil {
 		reqLogger.Error("Failed to registrate user", zap.Error(err))
 		switch {
 		case stderr.Is(err, errors.ErrUserAlreadyExists):
 			http.Error(w, err.Error(), http.StatusForbidden)
-		case stderr.Is(err, errors.ErrInvalidEmail):
+		case stderr.Is(err, errors.ErrInvalidEmail), stderr.Is(err, errors.ErrDisposableEmail):
 			http.Error(w, err.Error(), http.StatusBadRequest)
 		default:
 			http.Error(w, err.Error(), http.StatusForbidden)
@@ -97,6 +108,8 @@ func (c *AuthController) LoginUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	request.UserAgent, request.IP = r.UserAgent(), clientIP(r)
+
 	response, err := c.srv.LoginUser(&request)
 	if err != nil {
 		reqLogger.Error("Failed to login user", zap.Error(err))
@@ -123,6 +136,7 @@ func (c *AuthController) LoginUser(w http.ResponseWriter, r *http.Request) {
 
 // RefreshUserToken godoc
 // @Summary Обновить токен пользователя
+// @Description Выдает новую пару токенов той же сессии. Предъявленный refresh-токен перестает действовать, а его повторное использование отзывает всю сессию
 // @Tags Роли пользователей и аутентификация
 // @Accept json
 // @Produce json
@@ -165,3 +179,292 @@ func (c *AuthController) RefreshUserToken(w http.ResponseWriter, r *http.Request
 	}
 	reqLogger.Info("Refresh User Token done")
 }
+
+// LogoutUser godoc
+// @Summary Выйти из текущей сессии
+// @Description Отзывает сессию refresh-токена, после чего ее токены перестают действовать
+// @Tags Роли пользователей и аутентификация
+// @Accept json
+// @Produce json
+// @Param request body dto.LogoutUserRequest true "Refresh-токен сессии"
+// @Success 200 {object} dto.LogoutUserResponse
+// @Failure 400 {string} errors.ErrInvalidRefreshToken
+// @Router /api/auth/logout [post]
+func (c *AuthController) LogoutUser(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "LogoutUser"))
+
+	reqLogger.Info("Logout User")
+
+	var request dto.LogoutUserRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		reqLogger.Error("Failed to decode request", zap.Error(err))
+		http.Error(w, errors.ErrIncorrectData.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := c.srv.LogoutUser(&request)
+	if err != nil {
+		reqLogger.Error("Failed to logout user", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrInvalidRefreshToken):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("Logout User done")
+}
+
+// LogoutUserEverywhere godoc
+// @Summary Выйти из всех сессий
+// @Description Отзывает все сессии и все выданные ранее access-токены пользователя
+// @Tags Роли пользователей и аутентификация
+// @Accept json
+// @Produce json
+// @Param request body dto.LogoutUserRequest true "Refresh-токен сессии"
+// @Success 200 {object} dto.LogoutUserResponse
+// @Failure 400 {string} errors.ErrInvalidRefreshToken
+// @Router /api/auth/logout-all [post]
+func (c *AuthController) LogoutUserEverywhere(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "LogoutUserEverywhere"))
+
+	reqLogger.Info("Logout User Everywhere")
+
+	var request dto.LogoutUserRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		reqLogger.Error("Failed to decode request", zap.Error(err))
+		http.Error(w, errors.ErrIncorrectData.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := c.srv.LogoutUserEverywhere(&request)
+	if err != nil {
+		reqLogger.Error("Failed to logout user everywhere", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrInvalidRefreshToken):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("Logout User Everywhere done")
+}
+
+// ForgotPassword godoc
+// @Summary Запросить сброс пароля
+// @Description Отправляет на почту одноразовую ссылку для сброса пароля. Ответ не зависит от того, зарегистрирован ли адрес
+// @Tags Роли пользователей и аутентификация
+// @Accept json
+// @Produce json
+// @Param request body dto.ForgotPasswordRequest true "Почта пользователя"
+// @Success 200 {object} dto.ForgotPasswordResponse
+// @Failure 400 {string} errors.ErrInvalidEmail "invalid email"
+// @Router /api/auth/password/forgot [post]
+func (c *AuthController) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ForgotPassword"))
+
+	reqLogger.Info("Forgot Password")
+
+	var request dto.ForgotPasswordRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		reqLogger.Error("Failed to decode request", zap.Error(err))
+		http.Error(w, errors.ErrIncorrectData.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := c.srv.ForgotPassword(&request)
+	if err != nil {
+		reqLogger.Error("Failed to request password reset", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrInvalidEmail):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, errors.ErrInternalServerError.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("Forgot Password done")
+}
+
+// ResetPassword godoc
+// @Summary Сбросить пароль
+// @Description Устанавливает новый пароль по токену из письма и завершает все сессии пользователя. Токен одноразовый и ограничен по времени
+// @Tags Роли пользователей и аутентификация
+// @Accept json
+// @Produce json
+// @Param request body dto.ResetPasswordRequest true "Токен из письма и новый пароль"
+// @Success 200 {object} dto.ResetPasswordResponse
+// @Failure 400 {string} errors.ErrInvalidResetToken "invalid password reset token"
+// @Failure 400 {string} errors.ErrInvalidPassword "invalid password"
+// @Router /api/auth/password/reset [post]
+func (c *AuthController) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ResetPassword"))
+
+	reqLogger.Info("Reset Password")
+
+	var request dto.ResetPasswordRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		reqLogger.Error("Failed to decode request", zap.Error(err))
+		http.Error(w, errors.ErrIncorrectData.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := c.srv.ResetPassword(&request)
+	if err != nil {
+		reqLogger.Error("Failed to reset password", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrInvalidResetToken), stderr.Is(err, errors.ErrInvalidPassword):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("Reset Password done")
+}
+
+// VerifyEmail godoc
+// @Summary Подтвердить почту
+// @Description Подтверждает почту по токену из письма, отправленного при регистрации
+// @Tags Роли пользователей и аутентификация
+// @Accept json
+// @Produce json
+// @Param request body dto.VerifyEmailRequest true "Токен из письма"
+// @Success 200 {object} dto.VerifyEmailResponse
+// @Failure 400 {string} errors.ErrInvalidVerificationToken "invalid email verification token"
+// @Router /api/auth/verify-email [post]
+func (c *AuthController) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "VerifyEmail"))
+
+	reqLogger.Info("Verify Email")
+
+	var request dto.VerifyEmailRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		reqLogger.Error("Failed to decode request", zap.Error(err))
+		http.Error(w, errors.ErrIncorrectData.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := c.srv.VerifyEmail(&request)
+	if err != nil {
+		reqLogger.Error("Failed to verify email", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrInvalidVerificationToken):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("Verify Email done")
+}
+
+// ResendVerification godoc
+// @Summary Повторно отправить письмо для подтверждения почты
+// @Description Предыдущие ссылки перестают действовать. Письмо можно запрашивать не чаще одного раза в EMAIL_VERIFICATION_RESEND_INTERVAL
+// @Tags Роли пользователей и аутентификация
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Токен авторизации"
+// @Success 200 {object} dto.ResendVerificationResponse
+// @Failure 400 {string} errors.ErrEmailAlreadyVerified "email already verified"
+// @Failure 429 {string} errors.ErrTooManyRequests "too many requests"
+// @Failure 503 {string} errors.ErrMailQueueFull "mail queue is full"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/auth/verify-email/resend [post]
+func (c *AuthController) ResendVerification(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ResendVerification"))
+
+	reqLogger.Info("Resend Verification")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.ResendVerificationRequest
+	rows.UserId = user.UserId
+
+	response, err := c.srv.ResendVerification(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to resend verification", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrEmailAlreadyVerified):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case stderr.Is(err, errors.ErrTooManyRequests):
+			http.Error(w, err.Error(), http.StatusTooManyRequests)
+		case stderr.Is(err, errors.ErrMailQueueFull):
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		default:
+			http.Error(w, errors.ErrInternalServerError.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("Resend Verification done")
+}
+
+// clientIP returns the address of the peer the request came from.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -2,9 +2,11 @@ package controllers
 
 import (
 	"blog/internal/models/dto"
+	"blog/internal/models/entities"
 	"blog/pkg/consts"
 	"blog/pkg/consts/errors"
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -43,6 +45,54 @@ func (m *MockAuthService) RefreshUserToken(token *dto.RefreshUserTokenRequest) (
 	return args.Get(0).(*dto.RefreshUserTokenResponse), args.Error(1)
 }
 
+func (m *MockAuthService) LogoutUser(rows *dto.LogoutUserRequest) (*dto.LogoutUserResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.LogoutUserResponse), args.Error(1)
+}
+
+func (m *MockAuthService) LogoutUserEverywhere(rows *dto.LogoutUserRequest) (*dto.LogoutUserResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.LogoutUserResponse), args.Error(1)
+}
+
+func (m *MockAuthService) ForgotPassword(rows *dto.ForgotPasswordRequest) (*dto.ForgotPasswordResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ForgotPasswordResponse), args.Error(1)
+}
+
+func (m *MockAuthService) ResetPassword(rows *dto.ResetPasswordRequest) (*dto.ResetPasswordResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ResetPasswordResponse), args.Error(1)
+}
+
+func (m *MockAuthService) VerifyEmail(rows *dto.VerifyEmailRequest) (*dto.VerifyEmailResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.VerifyEmailResponse), args.Error(1)
+}
+
+func (m *MockAuthService) ResendVerification(rows *dto.ResendVerificationRequest) (*dto.ResendVerificationResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ResendVerificationResponse), args.Error(1)
+}
+
 func TestAuthController_RegistrateUser(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -106,6 +156,19 @@ func TestAuthController_RegistrateUser(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusBadRequest,
 		},
+		{
+			name: "disposable email",
+			requestBody: &dto.RegistrateUserRequest{
+				Email:    "test@mailinator.com",
+				Password: "password",
+				Role:     consts.AuthorRole,
+			},
+			mockFunc: func(m *MockAuthService) {
+				m.On("RegistrateUser", mock.AnythingOfType("*dto.RegistrateUserRequest")).
+					Return(nil, errors.ErrDisposableEmail)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
 		{
 			name: "invalid role",
 			requestBody: &dto.RegistrateUserRequest{
@@ -372,3 +435,543 @@ func TestAuthController_RefreshUserToken(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthController_LogoutUser(t *testing.T) {
+	tests := []struct {
+		name               string
+		requestBody        interface{}
+		mockFunc           func(m *MockAuthService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			requestBody: &dto.LogoutUserRequest{
+				RefreshToken: "refresh_token",
+			},
+			mockFunc: func(m *MockAuthService) {
+				m.On("LogoutUser", &dto.LogoutUserRequest{RefreshToken: "refresh_token"}).
+					Return(&dto.LogoutUserResponse{
+						Message: "message",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.LogoutUserResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "message", response.Message)
+			},
+		},
+		{
+			name:               "incorrect data",
+			requestBody:        nil,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "revoked refresh token",
+			requestBody: &dto.LogoutUserRequest{
+				RefreshToken: "refresh_token",
+			},
+			mockFunc: func(m *MockAuthService) {
+				m.On("LogoutUser", mock.AnythingOfType("*dto.LogoutUserRequest")).
+					Return(nil, errors.ErrInvalidRefreshToken)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "internal server error",
+			requestBody: &dto.LogoutUserRequest{
+				RefreshToken: "refresh_token",
+			},
+			mockFunc: func(m *MockAuthService) {
+				m.On("LogoutUser", mock.AnythingOfType("*dto.LogoutUserRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockAuthService := &MockAuthService{secret: "test"}
+			if test.mockFunc != nil {
+				test.mockFunc(mockAuthService)
+			}
+			controller := NewAuthController(mockAuthService)
+
+			req := &http.Request{}
+			if test.requestBody != nil {
+				body, _ := json.Marshal(test.requestBody)
+				req = httptest.NewRequest(http.MethodPost, "/api/auth/logout", bytes.NewBuffer(body))
+			} else {
+				req = httptest.NewRequest(http.MethodPost, "/api/auth/logout", nil)
+			}
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+
+			controller.LogoutUser(rr, req)
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockAuthService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthController_LogoutUserEverywhere(t *testing.T) {
+	tests := []struct {
+		name               string
+		requestBody        interface{}
+		mockFunc           func(m *MockAuthService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			requestBody: &dto.LogoutUserRequest{
+				RefreshToken: "refresh_token",
+			},
+			mockFunc: func(m *MockAuthService) {
+				m.On("LogoutUserEverywhere", &dto.LogoutUserRequest{RefreshToken: "refresh_token"}).
+					Return(&dto.LogoutUserResponse{
+						Message: "message",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.LogoutUserResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "message", response.Message)
+			},
+		},
+		{
+			name:               "incorrect data",
+			requestBody:        nil,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "revoked refresh token",
+			requestBody: &dto.LogoutUserRequest{
+				RefreshToken: "refresh_token",
+			},
+			mockFunc: func(m *MockAuthService) {
+				m.On("LogoutUserEverywhere", mock.AnythingOfType("*dto.LogoutUserRequest")).
+					Return(nil, errors.ErrInvalidRefreshToken)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "internal server error",
+			requestBody: &dto.LogoutUserRequest{
+				RefreshToken: "refresh_token",
+			},
+			mockFunc: func(m *MockAuthService) {
+				m.On("LogoutUserEverywhere", mock.AnythingOfType("*dto.LogoutUserRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockAuthService := &MockAuthService{secret: "test"}
+			if test.mockFunc != nil {
+				test.mockFunc(mockAuthService)
+			}
+			controller := NewAuthController(mockAuthService)
+
+			req := &http.Request{}
+			if test.requestBody != nil {
+				body, _ := json.Marshal(test.requestBody)
+				req = httptest.NewRequest(http.MethodPost, "/api/auth/logout-all", bytes.NewBuffer(body))
+			} else {
+				req = httptest.NewRequest(http.MethodPost, "/api/auth/logout-all", nil)
+			}
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+
+			controller.LogoutUserEverywhere(rr, req)
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockAuthService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthController_ForgotPassword(t *testing.T) {
+	tests := []struct {
+		name               string
+		requestBody        interface{}
+		mockFunc           func(m *MockAuthService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			requestBody: &dto.ForgotPasswordRequest{
+				Email: "test@yandex.ru",
+			},
+			mockFunc: func(m *MockAuthService) {
+				m.On("ForgotPassword", mock.AnythingOfType("*dto.ForgotPasswordRequest")).
+					Return(&dto.ForgotPasswordResponse{
+						Message: "message",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.ForgotPasswordResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "message", response.Message)
+			},
+		},
+		{
+			name:               "incorrect data",
+			requestBody:        nil,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "invalid email",
+			requestBody: &dto.ForgotPasswordRequest{
+				Email: "test@yandex.ru",
+			},
+			mockFunc: func(m *MockAuthService) {
+				m.On("ForgotPassword", mock.AnythingOfType("*dto.ForgotPasswordRequest")).
+					Return(nil, errors.ErrInvalidEmail)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "internal server error",
+			requestBody: &dto.ForgotPasswordRequest{
+				Email: "test@yandex.ru",
+			},
+			mockFunc: func(m *MockAuthService) {
+				m.On("ForgotPassword", mock.AnythingOfType("*dto.ForgotPasswordRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockAuthService := &MockAuthService{secret: "test"}
+			if test.mockFunc != nil {
+				test.mockFunc(mockAuthService)
+			}
+			controller := NewAuthController(mockAuthService)
+
+			req := &http.Request{}
+			if test.requestBody != nil {
+				body, _ := json.Marshal(test.requestBody)
+				req = httptest.NewRequest(http.MethodPost, "/api/auth/password/forgot", bytes.NewBuffer(body))
+			} else {
+				req = httptest.NewRequest(http.MethodPost, "/api/auth/password/forgot", nil)
+			}
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+
+			controller.ForgotPassword(rr, req)
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockAuthService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthController_ResetPassword(t *testing.T) {
+	tests := []struct {
+		name               string
+		requestBody        interface{}
+		mockFunc           func(m *MockAuthService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			requestBody: &dto.ResetPasswordRequest{
+				Token:    "token",
+				Password: "password",
+			},
+			mockFunc: func(m *MockAuthService) {
+				m.On("ResetPassword", mock.AnythingOfType("*dto.ResetPasswordRequest")).
+					Return(&dto.ResetPasswordResponse{
+						Message: "message",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.ResetPasswordResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "message", response.Message)
+			},
+		},
+		{
+			name:               "incorrect data",
+			requestBody:        nil,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "invalid reset token",
+			requestBody: &dto.ResetPasswordRequest{
+				Token:    "token",
+				Password: "password",
+			},
+			mockFunc: func(m *MockAuthService) {
+				m.On("ResetPassword", mock.AnythingOfType("*dto.ResetPasswordRequest")).
+					Return(nil, errors.ErrInvalidResetToken)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "invalid password",
+			requestBody: &dto.ResetPasswordRequest{
+				Token:    "token",
+				Password: "password",
+			},
+			mockFunc: func(m *MockAuthService) {
+				m.On("ResetPassword", mock.AnythingOfType("*dto.ResetPasswordRequest")).
+					Return(nil, errors.ErrInvalidPassword)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "internal server error",
+			requestBody: &dto.ResetPasswordRequest{
+				Token:    "token",
+				Password: "password",
+			},
+			mockFunc: func(m *MockAuthService) {
+				m.On("ResetPassword", mock.AnythingOfType("*dto.ResetPasswordRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockAuthService := &MockAuthService{secret: "test"}
+			if test.mockFunc != nil {
+				test.mockFunc(mockAuthService)
+			}
+			controller := NewAuthController(mockAuthService)
+
+			req := &http.Request{}
+			if test.requestBody != nil {
+				body, _ := json.Marshal(test.requestBody)
+				req = httptest.NewRequest(http.MethodPost, "/api/auth/password/reset", bytes.NewBuffer(body))
+			} else {
+				req = httptest.NewRequest(http.MethodPost, "/api/auth/password/reset", nil)
+			}
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+
+			controller.ResetPassword(rr, req)
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockAuthService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthController_VerifyEmail(t *testing.T) {
+	tests := []struct {
+		name               string
+		requestBody        interface{}
+		mockFunc           func(m *MockAuthService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			requestBody: &dto.VerifyEmailRequest{
+				Token: "token",
+			},
+			mockFunc: func(m *MockAuthService) {
+				m.On("VerifyEmail", mock.AnythingOfType("*dto.VerifyEmailRequest")).
+					Return(&dto.VerifyEmailResponse{
+						Message: "message",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.VerifyEmailResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "message", response.Message)
+			},
+		},
+		{
+			name:               "incorrect data",
+			requestBody:        nil,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "invalid verification token",
+			requestBody: &dto.VerifyEmailRequest{
+				Token: "token",
+			},
+			mockFunc: func(m *MockAuthService) {
+				m.On("VerifyEmail", mock.AnythingOfType("*dto.VerifyEmailRequest")).
+					Return(nil, errors.ErrInvalidVerificationToken)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "internal server error",
+			requestBody: &dto.VerifyEmailRequest{
+				Token: "token",
+			},
+			mockFunc: func(m *MockAuthService) {
+				m.On("VerifyEmail", mock.AnythingOfType("*dto.VerifyEmailRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockAuthService := &MockAuthService{secret: "test"}
+			if test.mockFunc != nil {
+				test.mockFunc(mockAuthService)
+			}
+			controller := NewAuthController(mockAuthService)
+
+			req := &http.Request{}
+			if test.requestBody != nil {
+				body, _ := json.Marshal(test.requestBody)
+				req = httptest.NewRequest(http.MethodPost, "/api/auth/verify-email", bytes.NewBuffer(body))
+			} else {
+				req = httptest.NewRequest(http.MethodPost, "/api/auth/verify-email", nil)
+			}
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+
+			controller.VerifyEmail(rr, req)
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockAuthService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthController_ResendVerification(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockAuthService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockAuthService) {
+				m.On("ResendVerification", mock.AnythingOfType("*dto.ResendVerificationRequest")).
+					Return(&dto.ResendVerificationResponse{
+						Message: "message",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.ResendVerificationResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "message", response.Message)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.AuthorRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "email already verified",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockAuthService) {
+				m.On("ResendVerification", mock.AnythingOfType("*dto.ResendVerificationRequest")).
+					Return(nil, errors.ErrEmailAlreadyVerified)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "too many requests",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockAuthService) {
+				m.On("ResendVerification", mock.AnythingOfType("*dto.ResendVerificationRequest")).
+					Return(nil, errors.ErrTooManyRequests)
+			},
+			expectedStatusCode: http.StatusTooManyRequests,
+		},
+		{
+			name: "internal server error",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockAuthService) {
+				m.On("ResendVerification", mock.AnythingOfType("*dto.ResendVerificationRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockAuthService := &MockAuthService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockAuthService)
+			}
+
+			controller := NewAuthController(mockAuthService)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/auth/verify-email/resend", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ResendVerification(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockAuthService.AssertExpectations(t)
+		})
+	}
+}
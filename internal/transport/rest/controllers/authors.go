@@ -0,0 +1,122 @@
+package controllers
+
+import (
+	"blog/internal/logger"
+	"blog/internal/models/dto"
+	"blog/pkg/consts/errors"
+	"encoding/json"
+	stderr "errors"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+type AuthorsService interface {
+	ViewAuthor(rows *dto.GetAuthorRequest) (*dto.GetAuthorResponse, error)
+	ViewAuthorPosts(rows *dto.GetAuthorPostsRequest) (*dto.GetPostsResponse, error)
+}
+
+type AuthorsController struct {
+	srv AuthorsService
+}
+
+func NewAuthorsController(srv AuthorsService) *AuthorsController {
+	return &AuthorsController{
+		srv: srv,
+	}
+}
+
+// ViewAuthor godoc
+// @Summary Страница автора
+// @Tags Авторы
+// @Accept json
+// @Produce json
+// @Param authorId path string true "ID автора"
+// @Success 200 {object} dto.GetAuthorResponse
+// @Failure 404 {string} errors.ErrAuthorNotFound "author not found"
+// @Router /api/authors/{authorId} [get]
+func (c *AuthorsController) ViewAuthor(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewAuthor"))
+
+	reqLogger.Info("View Author")
+
+	var rows dto.GetAuthorRequest
+	rows.AuthorId = r.PathValue("authorId")
+
+	response, err := c.srv.ViewAuthor(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to view author", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrAuthorNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, errors.ErrInternalServerError.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("ViewAuthor done")
+}
+
+// ViewAuthorPosts godoc
+// @Summary Опубликованные посты автора
+// @Tags Авторы
+// @Accept json
+// @Produce json
+// @Param authorId path string true "ID автора"
+// @Param limit query int false "Количество постов на странице (1-100)"
+// @Param cursor query string false "Курсор следующей страницы"
+// @Param sort query string false "Поле сортировки (created_at, updated_at)"
+// @Param order query string false "Порядок сортировки (asc, desc)"
+// @Param from query string false "Начало периода создания (RFC 3339)"
+// @Param to query string false "Конец периода создания (RFC 3339)"
+// @Success 200 {object} dto.GetPostsResponse
+// @Failure 400 {string} errors.ErrInvalidQueryParams "invalid query params"
+// @Failure 404 {string} errors.ErrAuthorNotFound "author not found"
+// @Router /api/authors/{authorId}/posts [get]
+func (c *AuthorsController) ViewAuthorPosts(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewAuthorPosts"))
+
+	reqLogger.Info("View Author Posts")
+
+	query, err := parsePostsQuery(r)
+	if err != nil {
+		reqLogger.Error("Failed to parse query", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var rows dto.GetAuthorPostsRequest
+	rows.AuthorId = r.PathValue("authorId")
+	rows.PostsQuery = query
+
+	response, err := c.srv.ViewAuthorPosts(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to view author posts", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrAuthorNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case isBadQueryError(err):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, errors.ErrInternalServerError.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("ViewAuthorPosts done")
+}
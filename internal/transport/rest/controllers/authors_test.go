@@ -0,0 +1,199 @@
+package controllers
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAuthorsService struct {
+	mock.Mock
+}
+
+func (m *MockAuthorsService) ViewAuthor(rows *dto.GetAuthorRequest) (*dto.GetAuthorResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetAuthorResponse), args.Error(1)
+}
+
+func (m *MockAuthorsService) ViewAuthorPosts(rows *dto.GetAuthorPostsRequest) (*dto.GetPostsResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetPostsResponse), args.Error(1)
+}
+
+func TestAuthorsController_ViewAuthor(t *testing.T) {
+	authorId := uuid.New().String()
+
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockAuthorsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockAuthorsService) {
+				m.On("ViewAuthor", mock.AnythingOfType("*dto.GetAuthorRequest")).
+					Return(&dto.GetAuthorResponse{
+						Author: entities.Author{AuthorId: authorId, PostsCount: 3},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetAuthorResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, 3, response.Author.PostsCount)
+			},
+		},
+		{
+			name: "author not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockAuthorsService) {
+				m.On("ViewAuthor", mock.AnythingOfType("*dto.GetAuthorRequest")).
+					Return(nil, errors.ErrAuthorNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockAuthorsService) {
+				m.On("ViewAuthor", mock.AnythingOfType("*dto.GetAuthorRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockAuthorsService := &MockAuthorsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockAuthorsService)
+			}
+
+			controller := NewAuthorsController(mockAuthorsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/authors/"+authorId, nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewAuthor(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockAuthorsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthorsController_ViewAuthorPosts(t *testing.T) {
+	authorId := uuid.New().String()
+
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockAuthorsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockAuthorsService) {
+				m.On("ViewAuthorPosts", mock.AnythingOfType("*dto.GetAuthorPostsRequest")).
+					Return(&dto.GetPostsResponse{
+						Posts: make([]entities.Post, 1),
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetPostsResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, len(response.Posts))
+			},
+		},
+		{
+			name: "author not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockAuthorsService) {
+				m.On("ViewAuthorPosts", mock.AnythingOfType("*dto.GetAuthorPostsRequest")).
+					Return(nil, errors.ErrAuthorNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "invalid cursor",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockAuthorsService) {
+				m.On("ViewAuthorPosts", mock.AnythingOfType("*dto.GetAuthorPostsRequest")).
+					Return(nil, errors.ErrInvalidCursor)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockAuthorsService := &MockAuthorsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockAuthorsService)
+			}
+
+			controller := NewAuthorsController(mockAuthorsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/authors/"+authorId+"/posts?limit=10", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewAuthorPosts(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockAuthorsService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package controllers
+
+import (
+	"blog/internal/logger"
+	"blog/internal/models/dto"
+	"blog/pkg/consts/errors"
+	"encoding/json"
+	stderr "errors"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+)
+
+type BookmarksService interface {
+	AddBookmark(rows *dto.AddBookmarkRequest) (*dto.AddBookmarkResponse, error)
+	DeleteBookmark(rows *dto.DeleteBookmarkRequest) (*dto.DeleteBookmarkResponse, error)
+	ViewBookmarks(rows *dto.GetBookmarksRequest) (*dto.GetBookmarksResponse, error)
+}
+
+type BookmarksController struct {
+	srv BookmarksService
+}
+
+func NewBookmarksController(srv BookmarksService) *BookmarksController {
+	return &BookmarksController{
+		srv: srv,
+	}
+}
+
+// AddBookmark godoc
+// @Summary Добавить пост в закладки
+// @Tags Закладки
+// @Accept json
+// @Produce json
+// @Param postId path string true "ID поста"
+// @Param Authorization header string true "Токен авторизации"
+// @Success 200 {object} dto.AddBookmarkResponse
+// @Failure 404 {string} errors.ErrPostNotFound "post not found"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/users/me/bookmarks/{postId} [put]
+func (c *BookmarksController) AddBookmark(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "AddBookmark"))
+
+	reqLogger.Info("Add Bookmark")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.AddBookmarkRequest
+	rows.UserId = user.UserId
+	rows.PostId = r.PathValue("postId")
+
+	response, err := c.srv.AddBookmark(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to add bookmark", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrPostNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("AddBookmark done")
+}
+
+// DeleteBookmark godoc
+// @Summary Удалить пост из закладок
+// @Tags Закладки
+// @Accept json
+// @Produce json
+// @Param postId path string true "ID поста"
+// @Param Authorization header string true "Токен авторизации"
+// @Success 200 {object} dto.DeleteBookmarkResponse
+// @Failure 404 {string} errors.ErrBookmarkNotFound "bookmark not found"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/users/me/bookmarks/{postId} [delete]
+func (c *BookmarksController) DeleteBookmark(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "DeleteBookmark"))
+
+	reqLogger.Info("Delete Bookmark")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.DeleteBookmarkRequest
+	rows.UserId = user.UserId
+	rows.PostId = r.PathValue("postId")
+
+	response, err := c.srv.DeleteBookmark(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to delete bookmark", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrBookmarkNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("DeleteBookmark done")
+}
+
+// ViewBookmarks godoc
+// @Summary Закладки пользователя
+// @Description Снятые с публикации и удалённые посты в выдачу не попадают
+// @Tags Закладки
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Токен авторизации"
+// @Param limit query int false "Количество постов на странице (1-100)"
+// @Param cursor query string false "Курсор следующей страницы"
+// @Success 200 {object} dto.GetBookmarksResponse
+// @Failure 400 {string} errors.ErrInvalidQueryParams "invalid query params"
+// @Failure 400 {string} errors.ErrInvalidCursor "invalid cursor"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/users/me/bookmarks [get]
+func (c *BookmarksController) ViewBookmarks(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewBookmarks"))
+
+	reqLogger.Info("View Bookmarks")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.GetBookmarksRequest
+	rows.UserId = user.UserId
+	rows.Cursor = r.URL.Query().Get("cursor")
+
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil {
+			reqLogger.Error("Failed to parse query", zap.Error(err))
+			http.Error(w, errors.ErrInvalidQueryParams.Error(), http.StatusBadRequest)
+			return
+		}
+		rows.Limit = value
+	}
+
+	response, err := c.srv.ViewBookmarks(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to view bookmarks", zap.Error(err))
+		switch {
+		case isBadQueryError(err):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("ViewBookmarks done")
+}
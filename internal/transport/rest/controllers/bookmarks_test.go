@@ -0,0 +1,301 @@
+package controllers
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockBookmarksService struct {
+	mock.Mock
+}
+
+func (m *MockBookmarksService) AddBookmark(rows *dto.AddBookmarkRequest) (*dto.AddBookmarkResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.AddBookmarkResponse), args.Error(1)
+}
+
+func (m *MockBookmarksService) DeleteBookmark(rows *dto.DeleteBookmarkRequest) (*dto.DeleteBookmarkResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.DeleteBookmarkResponse), args.Error(1)
+}
+
+func (m *MockBookmarksService) ViewBookmarks(rows *dto.GetBookmarksRequest) (*dto.GetBookmarksResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetBookmarksResponse), args.Error(1)
+}
+
+func TestBookmarksController_AddBookmark(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockBookmarksService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockBookmarksService) {
+				m.On("AddBookmark", mock.AnythingOfType("*dto.AddBookmarkRequest")).
+					Return(&dto.AddBookmarkResponse{
+						Message: "message",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.AddBookmarkResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "message", response.Message)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.ReaderRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "post not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockBookmarksService) {
+				m.On("AddBookmark", mock.AnythingOfType("*dto.AddBookmarkRequest")).
+					Return(nil, errors.ErrPostNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockBookmarksService) {
+				m.On("AddBookmark", mock.AnythingOfType("*dto.AddBookmarkRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockBookmarksService := &MockBookmarksService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockBookmarksService)
+			}
+
+			controller := NewBookmarksController(mockBookmarksService)
+
+			req := httptest.NewRequest(http.MethodPut, "/api/users/me/bookmarks/postId", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.AddBookmark(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockBookmarksService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBookmarksController_DeleteBookmark(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockBookmarksService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockBookmarksService) {
+				m.On("DeleteBookmark", mock.AnythingOfType("*dto.DeleteBookmarkRequest")).
+					Return(&dto.DeleteBookmarkResponse{
+						Message: "message",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.DeleteBookmarkResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "message", response.Message)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.ReaderRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "bookmark not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockBookmarksService) {
+				m.On("DeleteBookmark", mock.AnythingOfType("*dto.DeleteBookmarkRequest")).
+					Return(nil, errors.ErrBookmarkNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockBookmarksService) {
+				m.On("DeleteBookmark", mock.AnythingOfType("*dto.DeleteBookmarkRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockBookmarksService := &MockBookmarksService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockBookmarksService)
+			}
+
+			controller := NewBookmarksController(mockBookmarksService)
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/users/me/bookmarks/postId", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.DeleteBookmark(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockBookmarksService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBookmarksController_ViewBookmarks(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockBookmarksService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockBookmarksService) {
+				m.On("ViewBookmarks", mock.AnythingOfType("*dto.GetBookmarksRequest")).
+					Return(&dto.GetBookmarksResponse{
+						Posts:      []entities.BookmarkedPost{{Post: entities.Post{PostId: "postId"}}},
+						NextCursor: "cursor",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetBookmarksResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "postId", response.Posts[0].Post.PostId)
+				assert.Equal(t, "cursor", response.NextCursor)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.ReaderRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "invalid cursor",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockBookmarksService) {
+				m.On("ViewBookmarks", mock.AnythingOfType("*dto.GetBookmarksRequest")).
+					Return(nil, errors.ErrInvalidCursor)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockBookmarksService) {
+				m.On("ViewBookmarks", mock.AnythingOfType("*dto.GetBookmarksRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockBookmarksService := &MockBookmarksService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockBookmarksService)
+			}
+
+			controller := NewBookmarksController(mockBookmarksService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/users/me/bookmarks?limit=10", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewBookmarks(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockBookmarksService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,327 @@
+package controllers
+
+import (
+	"blog/internal/logger"
+	"blog/internal/models/dto"
+	"blog/pkg/consts/errors"
+	"encoding/json"
+	stderr "errors"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+)
+
+type CommentsService interface {
+	CreateComment(rows *dto.CreateCommentRequest) (*dto.CreateCommentResponse, error)
+	EditComment(rows *dto.EditCommentRequest) (*dto.EditCommentResponse, error)
+	DeleteComment(rows *dto.DeleteCommentRequest) (*dto.DeleteCommentResponse, error)
+	ViewComments(rows *dto.GetCommentsRequest) (*dto.GetCommentsResponse, error)
+	ViewCommentReplies(rows *dto.GetCommentRepliesRequest) (*dto.GetCommentRepliesResponse, error)
+}
+
+type CommentsController struct {
+	srv CommentsService
+}
+
+func NewCommentsController(srv CommentsService) *CommentsController {
+	return &CommentsController{
+		srv: srv,
+	}
+}
+
+// CreateComment godoc
+// @Summary Оставить комментарий к посту
+// @Tags Комментарии
+// @Accept json
+// @Produce json
+// @Param postId path string true "ID поста"
+// @Param Authorization header string true "Токен авторизации"
+// @Param request body dto.CreateCommentRequest true "Текст комментария и ID родительского комментария"
+// @Success 201 {object} dto.CreateCommentResponse
+// @Failure 400 {string} errors.ErrInvalidComment "invalid comment"
+// @Failure 400 {string} errors.ErrInvalidParentComment "invalid parent comment"
+// @Failure 404 {string} errors.ErrPostNotFound "post not found"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/posts/{postId}/comments [post]
+func (c *CommentsController) CreateComment(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "CreateComment"))
+
+	reqLogger.Info("Create Comment")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.CreateCommentRequest
+	err = json.NewDecoder(r.Body).Decode(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to decode request body", zap.Error(err))
+		http.Error(w, errors.ErrIncorrectData.Error(), http.StatusBadRequest)
+		return
+	}
+	rows.AuthorId = user.UserId
+	rows.PostId = r.PathValue("postId")
+
+	response, err := c.srv.CreateComment(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to create comment", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrPostNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case stderr.Is(err, errors.ErrInvalidComment), stderr.Is(err, errors.ErrInvalidParentComment):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("CreateComment done")
+}
+
+// EditComment godoc
+// @Summary Редактировать свой комментарий
+// @Tags Комментарии
+// @Accept json
+// @Produce json
+// @Param postId path string true "ID поста"
+// @Param commentId path string true "ID комментария"
+// @Param Authorization header string true "Токен авторизации"
+// @Param request body dto.EditCommentRequest true "Новый текст комментария"
+// @Success 200 {object} dto.EditCommentResponse
+// @Failure 400 {string} errors.ErrInvalidComment "invalid comment"
+// @Failure 404 {string} errors.ErrCommentNotFound "comment not found"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/posts/{postId}/comments/{commentId} [put]
+func (c *CommentsController) EditComment(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "EditComment"))
+
+	reqLogger.Info("Edit Comment")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.EditCommentRequest
+	err = json.NewDecoder(r.Body).Decode(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to decode request body", zap.Error(err))
+		http.Error(w, errors.ErrIncorrectData.Error(), http.StatusBadRequest)
+		return
+	}
+	rows.AuthorId = user.UserId
+	rows.PostId = r.PathValue("postId")
+	rows.CommentId = r.PathValue("commentId")
+
+	response, err := c.srv.EditComment(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to edit comment", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrPostNotFound), stderr.Is(err, errors.ErrCommentNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case stderr.Is(err, errors.ErrInvalidComment):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("EditComment done")
+}
+
+// DeleteComment godoc
+// @Summary Удалить комментарий
+// @Description Удалить комментарий может его автор или автор поста. Если у комментария есть ответы, вместо него остаётся заглушка "[deleted]"
+// @Tags Комментарии
+// @Accept json
+// @Produce json
+// @Param postId path string true "ID поста"
+// @Param commentId path string true "ID комментария"
+// @Param Authorization header string true "Токен авторизации"
+// @Success 200 {object} dto.DeleteCommentResponse
+// @Failure 404 {string} errors.ErrCommentNotFound "comment not found"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/posts/{postId}/comments/{commentId} [delete]
+func (c *CommentsController) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "DeleteComment"))
+
+	reqLogger.Info("Delete Comment")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.DeleteCommentRequest
+	rows.UserId = user.UserId
+	rows.PostId = r.PathValue("postId")
+	rows.CommentId = r.PathValue("commentId")
+
+	response, err := c.srv.DeleteComment(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to delete comment", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrPostNotFound), stderr.Is(err, errors.ErrCommentNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("DeleteComment done")
+}
+
+// ViewComments godoc
+// @Summary Комментарии к посту
+// @Description Ветки комментариев постранично, в каждой ветке не больше 50 первых ответов. Если ответов больше, у ветки есть replies_cursor для их загрузки
+// @Tags Комментарии
+// @Accept json
+// @Produce json
+// @Param postId path string true "ID поста"
+// @Param limit query int false "Количество веток на странице (1-100)"
+// @Param cursor query string false "Курсор следующей страницы"
+// @Success 200 {object} dto.GetCommentsResponse
+// @Failure 400 {string} errors.ErrInvalidQueryParams "invalid query params"
+// @Failure 400 {string} errors.ErrInvalidCursor "invalid cursor"
+// @Failure 404 {string} errors.ErrPostNotFound "post not found"
+// @Router /api/posts/{postId}/comments [get]
+func (c *CommentsController) ViewComments(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewComments"))
+
+	reqLogger.Info("View Comments")
+
+	var rows dto.GetCommentsRequest
+	rows.PostId = r.PathValue("postId")
+	rows.Cursor = r.URL.Query().Get("cursor")
+	if user, err := getUserFromCtx(r); err == nil {
+		rows.UserId = user.UserId
+	}
+
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil {
+			reqLogger.Error("Failed to parse query", zap.Error(err))
+			http.Error(w, errors.ErrInvalidQueryParams.Error(), http.StatusBadRequest)
+			return
+		}
+		rows.Limit = value
+	}
+
+	response, err := c.srv.ViewComments(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to view comments", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrPostNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case isBadQueryError(err):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, errors.ErrInternalServerError.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("ViewComments done")
+}
+
+// ViewCommentReplies godoc
+// @Summary Ответы на комментарий
+// @Description Ответы на комментарий на любой глубине в хронологическом порядке, без вложенности. Первую страницу открывает replies_cursor ветки
+// @Tags Комментарии
+// @Accept json
+// @Produce json
+// @Param postId path string true "ID поста"
+// @Param commentId path string true "ID комментария"
+// @Param limit query int false "Количество ответов на странице (1-100)"
+// @Param cursor query string false "Курсор следующей страницы"
+// @Success 200 {object} dto.GetCommentRepliesResponse
+// @Failure 400 {string} errors.ErrInvalidQueryParams "invalid query params"
+// @Failure 400 {string} errors.ErrInvalidCursor "invalid cursor"
+// @Failure 404 {string} errors.ErrPostNotFound "post not found"
+// @Failure 404 {string} errors.ErrCommentNotFound "comment not found"
+// @Router /api/posts/{postId}/comments/{commentId}/replies [get]
+func (c *CommentsController) ViewCommentReplies(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewCommentReplies"))
+
+	reqLogger.Info("View Comment Replies")
+
+	var rows dto.GetCommentRepliesRequest
+	rows.PostId = r.PathValue("postId")
+	rows.CommentId = r.PathValue("commentId")
+	rows.Cursor = r.URL.Query().Get("cursor")
+	if user, err := getUserFromCtx(r); err == nil {
+		rows.UserId = user.UserId
+	}
+
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil {
+			reqLogger.Error("Failed to parse query", zap.Error(err))
+			http.Error(w, errors.ErrInvalidQueryParams.Error(), http.StatusBadRequest)
+			return
+		}
+		rows.Limit = value
+	}
+
+	response, err := c.srv.ViewCommentReplies(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to view comment replies", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrPostNotFound), stderr.Is(err, errors.ErrCommentNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case isBadQueryError(err):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, errors.ErrInternalServerError.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("ViewCommentReplies done")
+}
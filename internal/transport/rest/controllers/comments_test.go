@@ -0,0 +1,551 @@
+package controllers
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCommentsService struct {
+	mock.Mock
+}
+
+func (m *MockCommentsService) CreateComment(rows *dto.CreateCommentRequest) (*dto.CreateCommentResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.CreateCommentResponse), args.Error(1)
+}
+
+func (m *MockCommentsService) EditComment(rows *dto.EditCommentRequest) (*dto.EditCommentResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.EditCommentResponse), args.Error(1)
+}
+
+func (m *MockCommentsService) DeleteComment(rows *dto.DeleteCommentRequest) (*dto.DeleteCommentResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.DeleteCommentResponse), args.Error(1)
+}
+
+func (m *MockCommentsService) ViewComments(rows *dto.GetCommentsRequest) (*dto.GetCommentsResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetCommentsResponse), args.Error(1)
+}
+
+func (m *MockCommentsService) ViewCommentReplies(rows *dto.GetCommentRepliesRequest) (*dto.GetCommentRepliesResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetCommentRepliesResponse), args.Error(1)
+}
+
+func TestCommentsController_CreateComment(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockCommentsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("CreateComment", mock.AnythingOfType("*dto.CreateCommentRequest")).
+					Return(&dto.CreateCommentResponse{
+						Message: "message",
+						Comment: entities.Comment{CommentId: "commentId"},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusCreated,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.CreateCommentResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "commentId", response.Comment.CommentId)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.ReaderRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "post not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("CreateComment", mock.AnythingOfType("*dto.CreateCommentRequest")).
+					Return(nil, errors.ErrPostNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "invalid comment",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("CreateComment", mock.AnythingOfType("*dto.CreateCommentRequest")).
+					Return(nil, errors.ErrInvalidComment)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "invalid parent comment",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("CreateComment", mock.AnythingOfType("*dto.CreateCommentRequest")).
+					Return(nil, errors.ErrInvalidParentComment)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("CreateComment", mock.AnythingOfType("*dto.CreateCommentRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCommentsService := &MockCommentsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockCommentsService)
+			}
+
+			controller := NewCommentsController(mockCommentsService)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/posts/postId/comments", strings.NewReader(`{"content":"comment"}`))
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.CreateComment(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockCommentsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCommentsController_EditComment(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockCommentsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("EditComment", mock.AnythingOfType("*dto.EditCommentRequest")).
+					Return(&dto.EditCommentResponse{
+						Message: "message",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.EditCommentResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.NotEmpty(t, response.Message)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.ReaderRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "comment not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("EditComment", mock.AnythingOfType("*dto.EditCommentRequest")).
+					Return(nil, errors.ErrCommentNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "invalid comment",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("EditComment", mock.AnythingOfType("*dto.EditCommentRequest")).
+					Return(nil, errors.ErrInvalidComment)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "no permission",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("EditComment", mock.AnythingOfType("*dto.EditCommentRequest")).
+					Return(nil, errors.ErrNoPermission)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCommentsService := &MockCommentsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockCommentsService)
+			}
+
+			controller := NewCommentsController(mockCommentsService)
+
+			req := httptest.NewRequest(http.MethodPut, "/api/posts/postId/comments/commentId", strings.NewReader(`{"content":"comment"}`))
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.EditComment(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockCommentsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCommentsController_DeleteComment(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockCommentsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("DeleteComment", mock.AnythingOfType("*dto.DeleteCommentRequest")).
+					Return(&dto.DeleteCommentResponse{
+						Message: "message",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.DeleteCommentResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.NotEmpty(t, response.Message)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.AuthorRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "post not found",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("DeleteComment", mock.AnythingOfType("*dto.DeleteCommentRequest")).
+					Return(nil, errors.ErrPostNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "comment not found",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("DeleteComment", mock.AnythingOfType("*dto.DeleteCommentRequest")).
+					Return(nil, errors.ErrCommentNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "no permission",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("DeleteComment", mock.AnythingOfType("*dto.DeleteCommentRequest")).
+					Return(nil, errors.ErrNoPermission)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCommentsService := &MockCommentsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockCommentsService)
+			}
+
+			controller := NewCommentsController(mockCommentsService)
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/posts/postId/comments/commentId", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.DeleteComment(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockCommentsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCommentsController_ViewComments(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockCommentsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("ViewComments", mock.AnythingOfType("*dto.GetCommentsRequest")).
+					Return(&dto.GetCommentsResponse{
+						Comments: []entities.Comment{{CommentId: "commentId", Replies: []entities.Comment{{CommentId: "replyId"}}}},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetCommentsResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "replyId", response.Comments[0].Replies[0].CommentId)
+			},
+		},
+		{
+			name: "anonymous",
+			role: consts.ReaderRole,
+			key:  "testKey",
+			mockFunc: func(m *MockCommentsService) {
+				m.On("ViewComments", mock.AnythingOfType("*dto.GetCommentsRequest")).
+					Return(&dto.GetCommentsResponse{}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "post not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("ViewComments", mock.AnythingOfType("*dto.GetCommentsRequest")).
+					Return(nil, errors.ErrPostNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "invalid cursor",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("ViewComments", mock.AnythingOfType("*dto.GetCommentsRequest")).
+					Return(nil, errors.ErrInvalidCursor)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("ViewComments", mock.AnythingOfType("*dto.GetCommentsRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCommentsService := &MockCommentsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockCommentsService)
+			}
+
+			controller := NewCommentsController(mockCommentsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/posts/postId/comments?limit=10", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewComments(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockCommentsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCommentsController_ViewCommentReplies(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockCommentsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("ViewCommentReplies", mock.MatchedBy(func(rows *dto.GetCommentRepliesRequest) bool {
+					return rows.CommentId == "commentId" && rows.Cursor == "cursor" && rows.Limit == 10
+				})).
+					Return(&dto.GetCommentRepliesResponse{
+						Replies:    []entities.Comment{{CommentId: "replyId"}},
+						NextCursor: "next",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetCommentRepliesResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "replyId", response.Replies[0].CommentId)
+				assert.Equal(t, "next", response.NextCursor)
+			},
+		},
+		{
+			name: "comment not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("ViewCommentReplies", mock.AnythingOfType("*dto.GetCommentRepliesRequest")).
+					Return(nil, errors.ErrCommentNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "invalid cursor",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("ViewCommentReplies", mock.AnythingOfType("*dto.GetCommentRepliesRequest")).
+					Return(nil, errors.ErrInvalidCursor)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockCommentsService) {
+				m.On("ViewCommentReplies", mock.AnythingOfType("*dto.GetCommentRepliesRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCommentsService := &MockCommentsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockCommentsService)
+			}
+
+			controller := NewCommentsController(mockCommentsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/posts/postId/comments/commentId/replies?limit=10&cursor=cursor", nil)
+			req.SetPathValue("postId", "postId")
+			req.SetPathValue("commentId", "commentId")
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewCommentReplies(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockCommentsService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,306 @@
+package controllers
+
+import (
+	"blog/internal/logger"
+	"blog/internal/models/dto"
+	"blog/pkg/consts/errors"
+	"encoding/json"
+	stderr "errors"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+)
+
+type FollowsService interface {
+	FollowAuthor(rows *dto.FollowAuthorRequest) (*dto.FollowAuthorResponse, error)
+	UnfollowAuthor(rows *dto.UnfollowAuthorRequest) (*dto.UnfollowAuthorResponse, error)
+	ViewFollowers(rows *dto.GetFollowsRequest) (*dto.GetFollowsResponse, error)
+	ViewFollowing(rows *dto.GetFollowsRequest) (*dto.GetFollowsResponse, error)
+	ViewFeed(rows *dto.GetFeedRequest) (*dto.GetPostsResponse, error)
+}
+
+type FollowsController struct {
+	srv FollowsService
+}
+
+func NewFollowsController(srv FollowsService) *FollowsController {
+	return &FollowsController{
+		srv: srv,
+	}
+}
+
+// FollowAuthor godoc
+// @Summary Подписаться на автора
+// @Tags Подписки
+// @Accept json
+// @Produce json
+// @Param authorId path string true "ID автора"
+// @Param Authorization header string true "Токен авторизации"
+// @Success 200 {object} dto.FollowAuthorResponse
+// @Failure 400 {string} errors.ErrCannotFollowYourself "cannot follow yourself"
+// @Failure 404 {string} errors.ErrAuthorNotFound "author not found"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/users/me/following/{authorId} [put]
+func (c *FollowsController) FollowAuthor(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "FollowAuthor"))
+
+	reqLogger.Info("Follow Author")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.FollowAuthorRequest
+	rows.UserId = user.UserId
+	rows.AuthorId = r.PathValue("authorId")
+
+	response, err := c.srv.FollowAuthor(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to follow author", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrAuthorNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case stderr.Is(err, errors.ErrCannotFollowYourself):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("FollowAuthor done")
+}
+
+// UnfollowAuthor godoc
+// @Summary Отписаться от автора
+// @Tags Подписки
+// @Accept json
+// @Produce json
+// @Param authorId path string true "ID автора"
+// @Param Authorization header string true "Токен авторизации"
+// @Success 200 {object} dto.UnfollowAuthorResponse
+// @Failure 404 {string} errors.ErrFollowNotFound "follow not found"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/users/me/following/{authorId} [delete]
+func (c *FollowsController) UnfollowAuthor(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "UnfollowAuthor"))
+
+	reqLogger.Info("Unfollow Author")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.UnfollowAuthorRequest
+	rows.UserId = user.UserId
+	rows.AuthorId = r.PathValue("authorId")
+
+	response, err := c.srv.UnfollowAuthor(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to unfollow author", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrFollowNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("UnfollowAuthor done")
+}
+
+// ViewFollowers godoc
+// @Summary Подписчики автора
+// @Tags Подписки
+// @Accept json
+// @Produce json
+// @Param authorId path string true "ID автора"
+// @Param limit query int false "Количество пользователей на странице (1-100)"
+// @Param cursor query string false "Курсор следующей страницы"
+// @Success 200 {object} dto.GetFollowsResponse
+// @Failure 400 {string} errors.ErrInvalidQueryParams "invalid query params"
+// @Failure 400 {string} errors.ErrInvalidCursor "invalid cursor"
+// @Failure 404 {string} errors.ErrAuthorNotFound "author not found"
+// @Router /api/authors/{authorId}/followers [get]
+func (c *FollowsController) ViewFollowers(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewFollowers"))
+
+	reqLogger.Info("View Followers")
+
+	var rows dto.GetFollowsRequest
+	rows.AuthorId = r.PathValue("authorId")
+	rows.Cursor = r.URL.Query().Get("cursor")
+
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil {
+			reqLogger.Error("Failed to parse query", zap.Error(err))
+			http.Error(w, errors.ErrInvalidQueryParams.Error(), http.StatusBadRequest)
+			return
+		}
+		rows.Limit = value
+	}
+
+	response, err := c.srv.ViewFollowers(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to view followers", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrAuthorNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case isBadQueryError(err):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, errors.ErrInternalServerError.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("ViewFollowers done")
+}
+
+// ViewFollowing godoc
+// @Summary Авторы, на которых подписан автор
+// @Tags Подписки
+// @Accept json
+// @Produce json
+// @Param authorId path string true "ID автора"
+// @Param limit query int false "Количество пользователей на странице (1-100)"
+// @Param cursor query string false "Курсор следующей страницы"
+// @Success 200 {object} dto.GetFollowsResponse
+// @Failure 400 {string} errors.ErrInvalidQueryParams "invalid query params"
+// @Failure 400 {string} errors.ErrInvalidCursor "invalid cursor"
+// @Failure 404 {string} errors.ErrAuthorNotFound "author not found"
+// @Router /api/authors/{authorId}/following [get]
+func (c *FollowsController) ViewFollowing(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewFollowing"))
+
+	reqLogger.Info("View Following")
+
+	var rows dto.GetFollowsRequest
+	rows.AuthorId = r.PathValue("authorId")
+	rows.Cursor = r.URL.Query().Get("cursor")
+
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil {
+			reqLogger.Error("Failed to parse query", zap.Error(err))
+			http.Error(w, errors.ErrInvalidQueryParams.Error(), http.StatusBadRequest)
+			return
+		}
+		rows.Limit = value
+	}
+
+	response, err := c.srv.ViewFollowing(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to view following", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrAuthorNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case isBadQueryError(err):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, errors.ErrInternalServerError.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("ViewFollowing done")
+}
+
+// ViewFeed godoc
+// @Summary Лента постов авторов, на которых подписан пользователь
+// @Description Только опубликованные посты, сначала новые
+// @Tags Подписки
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Токен авторизации"
+// @Param limit query int false "Количество постов на странице (1-100)"
+// @Param cursor query string false "Курсор следующей страницы"
+// @Success 200 {object} dto.GetPostsResponse
+// @Failure 400 {string} errors.ErrInvalidQueryParams "invalid query params"
+// @Failure 400 {string} errors.ErrInvalidCursor "invalid cursor"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/feed [get]
+func (c *FollowsController) ViewFeed(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewFeed"))
+
+	reqLogger.Info("View Feed")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.GetFeedRequest
+	rows.UserId = user.UserId
+	rows.Cursor = r.URL.Query().Get("cursor")
+
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil {
+			reqLogger.Error("Failed to parse query", zap.Error(err))
+			http.Error(w, errors.ErrInvalidQueryParams.Error(), http.StatusBadRequest)
+			return
+		}
+		rows.Limit = value
+	}
+
+	response, err := c.srv.ViewFeed(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to view feed", zap.Error(err))
+		switch {
+		case isBadQueryError(err):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("ViewFeed done")
+}
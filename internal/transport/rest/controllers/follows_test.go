@@ -0,0 +1,507 @@
+package controllers
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockFollowsService struct {
+	mock.Mock
+}
+
+func (m *MockFollowsService) FollowAuthor(rows *dto.FollowAuthorRequest) (*dto.FollowAuthorResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.FollowAuthorResponse), args.Error(1)
+}
+
+func (m *MockFollowsService) UnfollowAuthor(rows *dto.UnfollowAuthorRequest) (*dto.UnfollowAuthorResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.UnfollowAuthorResponse), args.Error(1)
+}
+
+func (m *MockFollowsService) ViewFollowers(rows *dto.GetFollowsRequest) (*dto.GetFollowsResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetFollowsResponse), args.Error(1)
+}
+
+func (m *MockFollowsService) ViewFollowing(rows *dto.GetFollowsRequest) (*dto.GetFollowsResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetFollowsResponse), args.Error(1)
+}
+
+func (m *MockFollowsService) ViewFeed(rows *dto.GetFeedRequest) (*dto.GetPostsResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetPostsResponse), args.Error(1)
+}
+
+func TestFollowsController_FollowAuthor(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockFollowsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockFollowsService) {
+				m.On("FollowAuthor", mock.AnythingOfType("*dto.FollowAuthorRequest")).
+					Return(&dto.FollowAuthorResponse{
+						Message: "message",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.FollowAuthorResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "message", response.Message)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.ReaderRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "author not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockFollowsService) {
+				m.On("FollowAuthor", mock.AnythingOfType("*dto.FollowAuthorRequest")).
+					Return(nil, errors.ErrAuthorNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "follow yourself",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockFollowsService) {
+				m.On("FollowAuthor", mock.AnythingOfType("*dto.FollowAuthorRequest")).
+					Return(nil, errors.ErrCannotFollowYourself)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockFollowsService) {
+				m.On("FollowAuthor", mock.AnythingOfType("*dto.FollowAuthorRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockFollowsService := &MockFollowsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockFollowsService)
+			}
+
+			controller := NewFollowsController(mockFollowsService)
+
+			req := httptest.NewRequest(http.MethodPut, "/api/users/me/following/authorId", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.FollowAuthor(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockFollowsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestFollowsController_UnfollowAuthor(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockFollowsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockFollowsService) {
+				m.On("UnfollowAuthor", mock.AnythingOfType("*dto.UnfollowAuthorRequest")).
+					Return(&dto.UnfollowAuthorResponse{
+						Message: "message",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.UnfollowAuthorResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "message", response.Message)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.ReaderRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "follow not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockFollowsService) {
+				m.On("UnfollowAuthor", mock.AnythingOfType("*dto.UnfollowAuthorRequest")).
+					Return(nil, errors.ErrFollowNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockFollowsService) {
+				m.On("UnfollowAuthor", mock.AnythingOfType("*dto.UnfollowAuthorRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockFollowsService := &MockFollowsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockFollowsService)
+			}
+
+			controller := NewFollowsController(mockFollowsService)
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/users/me/following/authorId", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.UnfollowAuthor(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockFollowsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestFollowsController_ViewFollowers(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockFollowsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockFollowsService) {
+				m.On("ViewFollowers", mock.AnythingOfType("*dto.GetFollowsRequest")).
+					Return(&dto.GetFollowsResponse{
+						Users:      []entities.Follow{{UserId: "userId"}},
+						NextCursor: "cursor",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetFollowsResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "userId", response.Users[0].UserId)
+				assert.Equal(t, "cursor", response.NextCursor)
+			},
+		},
+		{
+			name: "author not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockFollowsService) {
+				m.On("ViewFollowers", mock.AnythingOfType("*dto.GetFollowsRequest")).
+					Return(nil, errors.ErrAuthorNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "invalid cursor",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockFollowsService) {
+				m.On("ViewFollowers", mock.AnythingOfType("*dto.GetFollowsRequest")).
+					Return(nil, errors.ErrInvalidCursor)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockFollowsService) {
+				m.On("ViewFollowers", mock.AnythingOfType("*dto.GetFollowsRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockFollowsService := &MockFollowsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockFollowsService)
+			}
+
+			controller := NewFollowsController(mockFollowsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/authors/authorId/followers?limit=10", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewFollowers(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockFollowsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestFollowsController_ViewFollowing(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockFollowsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockFollowsService) {
+				m.On("ViewFollowing", mock.AnythingOfType("*dto.GetFollowsRequest")).
+					Return(&dto.GetFollowsResponse{
+						Users:      []entities.Follow{{UserId: "userId"}},
+						NextCursor: "cursor",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetFollowsResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "userId", response.Users[0].UserId)
+				assert.Equal(t, "cursor", response.NextCursor)
+			},
+		},
+		{
+			name: "author not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockFollowsService) {
+				m.On("ViewFollowing", mock.AnythingOfType("*dto.GetFollowsRequest")).
+					Return(nil, errors.ErrAuthorNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "invalid cursor",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockFollowsService) {
+				m.On("ViewFollowing", mock.AnythingOfType("*dto.GetFollowsRequest")).
+					Return(nil, errors.ErrInvalidCursor)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockFollowsService) {
+				m.On("ViewFollowing", mock.AnythingOfType("*dto.GetFollowsRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockFollowsService := &MockFollowsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockFollowsService)
+			}
+
+			controller := NewFollowsController(mockFollowsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/authors/authorId/following?limit=10", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewFollowing(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockFollowsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestFollowsController_ViewFeed(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockFollowsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockFollowsService) {
+				m.On("ViewFeed", mock.AnythingOfType("*dto.GetFeedRequest")).
+					Return(&dto.GetPostsResponse{
+						Posts: []entities.Post{{PostId: "postId"}},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetPostsResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "postId", response.Posts[0].PostId)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.ReaderRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "invalid cursor",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockFollowsService) {
+				m.On("ViewFeed", mock.AnythingOfType("*dto.GetFeedRequest")).
+					Return(nil, errors.ErrInvalidCursor)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockFollowsService) {
+				m.On("ViewFeed", mock.AnythingOfType("*dto.GetFeedRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockFollowsService := &MockFollowsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockFollowsService)
+			}
+
+			controller := NewFollowsController(mockFollowsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/feed?limit=10", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewFeed(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockFollowsService.AssertExpectations(t)
+		})
+	}
+}
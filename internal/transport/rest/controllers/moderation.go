@@ -0,0 +1,225 @@
+package controllers
+
+import (
+	"blog/internal/logger"
+	"blog/internal/models/dto"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"encoding/json"
+	stderr "errors"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+)
+
+type ModerationService interface {
+	ViewModerationQueue(rows *dto.GetModerationQueueRequest) (*dto.GetModerationQueueResponse, error)
+	ModerateComment(rows *dto.ModerateCommentRequest) (*dto.ModerateCommentResponse, error)
+	ApproveComments(rows *dto.ApproveCommentsRequest) (*dto.ApproveCommentsResponse, error)
+}
+
+type ModerationController struct {
+	srv ModerationService
+}
+
+func NewModerationController(srv ModerationService) *ModerationController {
+	return &ModerationController{
+		srv: srv,
+	}
+}
+
+// ViewModerationQueue godoc
+// @Summary Очередь модерации комментариев к своим постам
+// @Tags Модерация
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Токен авторизации"
+// @Param status query string false "Статус комментариев (Pending, Approved, Rejected)"
+// @Param limit query int false "Количество комментариев на странице (1-100)"
+// @Param cursor query string false "Курсор следующей страницы"
+// @Success 200 {object} dto.GetModerationQueueResponse
+// @Failure 400 {string} errors.ErrInvalidQueryParams "invalid query params"
+// @Failure 400 {string} errors.ErrInvalidCursor "invalid cursor"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/moderation/comments [get]
+func (c *ModerationController) ViewModerationQueue(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewModerationQueue"))
+
+	reqLogger.Info("View Moderation Queue")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if user.Role != consts.AuthorRole {
+		reqLogger.Error("User have no permission", zap.Error(err))
+		http.Error(w, errors.ErrNoPermission.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.GetModerationQueueRequest
+	rows.AuthorId = user.UserId
+	rows.Status = r.URL.Query().Get("status")
+	rows.Cursor = r.URL.Query().Get("cursor")
+
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil {
+			reqLogger.Error("Failed to parse query", zap.Error(err))
+			http.Error(w, errors.ErrInvalidQueryParams.Error(), http.StatusBadRequest)
+			return
+		}
+		rows.Limit = value
+	}
+
+	response, err := c.srv.ViewModerationQueue(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to view moderation queue", zap.Error(err))
+		switch {
+		case isBadQueryError(err):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("ViewModerationQueue done")
+}
+
+// ModerateComment godoc
+// @Summary Одобрить или отклонить комментарий
+// @Tags Модерация
+// @Accept json
+// @Produce json
+// @Param commentId path string true "ID комментария"
+// @Param Authorization header string true "Токен авторизации"
+// @Param request body dto.ModerateCommentRequest true "Новый статус (Approved, Rejected)"
+// @Success 200 {object} dto.ModerateCommentResponse
+// @Failure 400 {string} errors.ErrInvalidCommentStatus "invalid comment status"
+// @Failure 404 {string} errors.ErrCommentNotFound "comment not found"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/moderation/comments/{commentId} [patch]
+func (c *ModerationController) ModerateComment(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ModerateComment"))
+
+	reqLogger.Info("Moderate Comment")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if user.Role != consts.AuthorRole {
+		reqLogger.Error("User have no permission", zap.Error(err))
+		http.Error(w, errors.ErrNoPermission.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.ModerateCommentRequest
+	err = json.NewDecoder(r.Body).Decode(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to decode request body", zap.Error(err))
+		http.Error(w, errors.ErrIncorrectData.Error(), http.StatusBadRequest)
+		return
+	}
+	rows.AuthorId = user.UserId
+	rows.CommentId = r.PathValue("commentId")
+
+	response, err := c.srv.ModerateComment(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to moderate comment", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrCommentNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case stderr.Is(err, errors.ErrInvalidCommentStatus):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("ModerateComment done")
+}
+
+// ApproveComments godoc
+// @Summary Одобрить несколько комментариев
+// @Description Комментарии к чужим постам пропускаются, в ответе количество одобренных
+// @Tags Модерация
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Токен авторизации"
+// @Param request body dto.ApproveCommentsRequest true "ID комментариев (до 100)"
+// @Success 200 {object} dto.ApproveCommentsResponse
+// @Failure 400 {string} errors.ErrInvalidCommentIds "invalid comment ids"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/moderation/comments/approve [post]
+func (c *ModerationController) ApproveComments(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ApproveComments"))
+
+	reqLogger.Info("Approve Comments")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if user.Role != consts.AuthorRole {
+		reqLogger.Error("User have no permission", zap.Error(err))
+		http.Error(w, errors.ErrNoPermission.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.ApproveCommentsRequest
+	err = json.NewDecoder(r.Body).Decode(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to decode request body", zap.Error(err))
+		http.Error(w, errors.ErrIncorrectData.Error(), http.StatusBadRequest)
+		return
+	}
+	rows.AuthorId = user.UserId
+
+	response, err := c.srv.ApproveComments(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to approve comments", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrInvalidCommentIds):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("ApproveComments done")
+}
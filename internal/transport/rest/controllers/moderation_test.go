@@ -0,0 +1,319 @@
+package controllers
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockModerationService struct {
+	mock.Mock
+}
+
+func (m *MockModerationService) ViewModerationQueue(rows *dto.GetModerationQueueRequest) (*dto.GetModerationQueueResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetModerationQueueResponse), args.Error(1)
+}
+
+func (m *MockModerationService) ModerateComment(rows *dto.ModerateCommentRequest) (*dto.ModerateCommentResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ModerateCommentResponse), args.Error(1)
+}
+
+func (m *MockModerationService) ApproveComments(rows *dto.ApproveCommentsRequest) (*dto.ApproveCommentsResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ApproveCommentsResponse), args.Error(1)
+}
+
+func TestModerationController_ViewModerationQueue(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockModerationService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockModerationService) {
+				m.On("ViewModerationQueue", mock.AnythingOfType("*dto.GetModerationQueueRequest")).
+					Return(&dto.GetModerationQueueResponse{
+						Comments: []entities.Comment{{CommentId: "commentId", Status: consts.PendingCommentState}},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetModerationQueueResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, consts.PendingCommentState, response.Comments[0].Status)
+			},
+		},
+		{
+			name:               "no permission",
+			role:               consts.ReaderRole,
+			key:                consts.CtxUserKey,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.AuthorRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "invalid query params",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockModerationService) {
+				m.On("ViewModerationQueue", mock.AnythingOfType("*dto.GetModerationQueueRequest")).
+					Return(nil, errors.ErrInvalidQueryParams)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "internal server error",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockModerationService) {
+				m.On("ViewModerationQueue", mock.AnythingOfType("*dto.GetModerationQueueRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockModerationService := &MockModerationService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockModerationService)
+			}
+
+			controller := NewModerationController(mockModerationService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/moderation/comments?status=Pending&limit=10", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewModerationQueue(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockModerationService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerationController_ModerateComment(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockModerationService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockModerationService) {
+				m.On("ModerateComment", mock.AnythingOfType("*dto.ModerateCommentRequest")).
+					Return(&dto.ModerateCommentResponse{
+						Message: "message",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.ModerateCommentResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.NotEmpty(t, response.Message)
+			},
+		},
+		{
+			name:               "no permission",
+			role:               consts.ReaderRole,
+			key:                consts.CtxUserKey,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.AuthorRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "comment not found",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockModerationService) {
+				m.On("ModerateComment", mock.AnythingOfType("*dto.ModerateCommentRequest")).
+					Return(nil, errors.ErrCommentNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "invalid comment status",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockModerationService) {
+				m.On("ModerateComment", mock.AnythingOfType("*dto.ModerateCommentRequest")).
+					Return(nil, errors.ErrInvalidCommentStatus)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockModerationService := &MockModerationService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockModerationService)
+			}
+
+			controller := NewModerationController(mockModerationService)
+
+			req := httptest.NewRequest(http.MethodPatch, "/api/moderation/comments/commentId", strings.NewReader(`{"status":"Approved"}`))
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ModerateComment(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockModerationService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerationController_ApproveComments(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockModerationService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockModerationService) {
+				m.On("ApproveComments", mock.AnythingOfType("*dto.ApproveCommentsRequest")).
+					Return(&dto.ApproveCommentsResponse{
+						Message:  "message",
+						Approved: 1,
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.ApproveCommentsResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, response.Approved)
+			},
+		},
+		{
+			name:               "no permission",
+			role:               consts.ReaderRole,
+			key:                consts.CtxUserKey,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.AuthorRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "invalid comment ids",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockModerationService) {
+				m.On("ApproveComments", mock.AnythingOfType("*dto.ApproveCommentsRequest")).
+					Return(nil, errors.ErrInvalidCommentIds)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "internal server error",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockModerationService) {
+				m.On("ApproveComments", mock.AnythingOfType("*dto.ApproveCommentsRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockModerationService := &MockModerationService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockModerationService)
+			}
+
+			controller := NewModerationController(mockModerationService)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/moderation/comments/approve", strings.NewReader(`{"comment_ids":["commentId"]}`))
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ApproveComments(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockModerationService.AssertExpectations(t)
+		})
+	}
+}
@@ -6,9 +6,16 @@ import (
 	"blog/internal/models/entities"
 	"blog/pkg/consts"
 	"blog/pkg/consts/errors"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	stderr "errors"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
 )
@@ -17,8 +24,18 @@ type PostsService interface {
 	CreatePost(post *dto.CreatePostRequest) (*dto.CreatePostResponse, error)
 	EditPost(rows *dto.EditPostRequest) (*dto.EditPostResponse, error)
 	PublishPost(post *dto.PublishPostRequest) (*dto.PublishPostResponse, error)
+	DeletePost(rows *dto.DeletePostRequest) (*dto.DeletePostResponse, error)
+	RestorePost(rows *dto.RestorePostRequest) (*dto.RestorePostResponse, error)
+	ViewTrash(rows *dto.GetTrashRequest) (*dto.GetPostsResponse, error)
+	ViewPost(rows *dto.GetPostRequest) (*dto.GetPostResponse, error)
+	ViewStatusHistory(rows *dto.GetStatusHistoryRequest) (*dto.GetStatusHistoryResponse, error)
 	ViewPostsById(rows *dto.GetPostsByIdRequest) (*dto.GetPostsResponse, error)
-	ViewAllPosts() (*dto.GetPostsResponse, error)
+	ViewAllPosts(rows *dto.GetAllPostsRequest) (*dto.GetPostsResponse, error)
+	SearchPosts(rows *dto.SearchPostsRequest) (*dto.SearchPostsResponse, error)
+	ViewRevisions(rows *dto.GetRevisionsRequest) (*dto.GetRevisionsResponse, error)
+	ViewRevision(rows *dto.GetRevisionRequest) (*dto.GetRevisionResponse, error)
+	DiffRevisions(rows *dto.DiffRevisionsRequest) (*dto.DiffRevisionsResponse, error)
+	RestoreRevision(rows *dto.RestoreRevisionRequest) (*dto.RestoreRevisionResponse, error)
 	AddImage(rows *dto.AddImageToPostRequest) (*dto.AddImageToPostResponse, error)
 	DeleteImage(rows *dto.DeleteImageFromPostRequest) (*dto.DeleteImageFromPostResponse, error)
 }
@@ -43,6 +60,77 @@ func getUserFromCtx(r *http.Request) (*entities.User, error) {
 	return user, nil
 }
 
+func parsePostsQuery(r *http.Request) (dto.PostsQuery, error) {
+	values := r.URL.Query()
+	query := dto.PostsQuery{
+		Cursor: values.Get("cursor"),
+		Sort:   values.Get("sort"),
+		Order:  values.Get("order"),
+		Status: values.Get("status"),
+		Author: values.Get("author"),
+	}
+
+	if limit := values.Get("limit"); limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil {
+			return query, errors.ErrInvalidQueryParams
+		}
+		query.Limit = value
+	}
+
+	for param, target := range map[string]**time.Time{"from": &query.From, "to": &query.To} {
+		if raw := values.Get(param); raw != "" {
+			value, err := time.Parse(time.RFC3339, raw)
+			if err != nil {
+				return query, errors.ErrInvalidQueryParams
+			}
+			*target = &value
+		}
+	}
+
+	return query, nil
+}
+
+func postETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// postBodyETag identifies the whole representation of the post, which also
+// changes with comments and reactions that leave the version as is. It
+// starts with the version, so parseIfMatch still accepts it.
+func postBodyETag(version int, body []byte) string {
+	hash := sha256.Sum256(body)
+	return strconv.Quote(strconv.Itoa(version) + "-" + hex.EncodeToString(hash[:16]))
+}
+
+// parseIfMatch returns the post version the client expects. "*" matches any
+// version, and weak tags are compared by their version like strong ones.
+func parseIfMatch(r *http.Request) (int, error) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" {
+		return 0, errors.ErrPreconditionRequired
+	}
+	if header == "*" {
+		return consts.AnyPostVersion, nil
+	}
+
+	tag, err := strconv.Unquote(strings.TrimPrefix(header, "W/"))
+	if err != nil {
+		return 0, errors.ErrPostVersionMismatch
+	}
+	tag, _, _ = strings.Cut(tag, "-")
+	version, err := strconv.Atoi(tag)
+	if err != nil {
+		return 0, errors.ErrPostVersionMismatch
+	}
+
+	return version, nil
+}
+
+func isBadQueryError(err error) bool {
+	return stderr.Is(err, errors.ErrInvalidQueryParams) || stderr.Is(err, errors.ErrInvalidCursor) || stderr.Is(err, errors.ErrInvalidLanguage)
+}
+
 // CreatePost godoc
 // @Summary Создать пост
 // @Tags Управление постами
@@ -52,6 +140,10 @@ func getUserFromCtx(r *http.Request) (*entities.User, error) {
 // @Param Authorization header string true "Токен авторизации"
 // @Success 200 {object} dto.CreatePostResponse
 // @Failure 409 {string} errors.ErrInvalidIdempotencyKey "invalid idempotency key"
+// @Failure 409 {string} errors.ErrSlugAlreadyExists "slug already exists"
+// @Failure 400 {string} errors.ErrInvalidLanguage "invalid language"
+// @Failure 400 {string} errors.ErrInvalidContentFormat "invalid content format"
+// @Failure 400 {string} errors.ErrInvalidTags "invalid tags"
 // @Failure 403 {string} errors.ErrNoPermission "no permission"
 // @Router /api/posts [post]
 func (c *PostsController) CreatePost(w http.ResponseWriter, r *http.Request) {
@@ -87,8 +179,10 @@ func (c *PostsController) CreatePost(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		reqLogger.Error("Failed to create post", zap.Error(err))
 		switch {
-		case stderr.Is(err, errors.ErrInvalidIdempotencyKey):
+		case stderr.Is(err, errors.ErrInvalidIdempotencyKey), stderr.Is(err, errors.ErrSlugAlreadyExists):
 			http.Error(w, err.Error(), http.StatusConflict)
+		case stderr.Is(err, errors.ErrInvalidLanguage), stderr.Is(err, errors.ErrInvalidContentFormat), stderr.Is(err, errors.ErrInvalidTags):
+			http.Error(w, err.Error(), http.StatusBadRequest)
 		default:
 			http.Error(w, err.Error(), http.StatusForbidden)
 		}
@@ -184,8 +278,16 @@ func (c *PostsController) AddImageToPost(w http.ResponseWriter, r *http.Request)
 // @Param postId path string true "ID поста"
 // @Param request body dto.EditPostRequest true "Новое название поста"
 // @Param Authorization header string true "Токен авторизации"
+// @Param If-Match header string true "ETag редактируемой версии поста или *"
 // @Success 200 {object} dto.EditPostResponse
+// @Header 200 {string} ETag "Новая версия поста"
+// @Failure 400 {string} errors.ErrInvalidLanguage "invalid language"
+// @Failure 400 {string} errors.ErrInvalidContentFormat "invalid content format"
+// @Failure 400 {string} errors.ErrInvalidTags "invalid tags"
 // @Failure 404 {string} errors.ErrPostNotFound "post not found"
+// @Failure 409 {string} errors.ErrSlugAlreadyExists "slug already exists"
+// @Failure 412 {string} errors.ErrPostVersionMismatch "post version mismatch"
+// @Failure 428 {string} errors.ErrPreconditionRequired "if-match header required"
 // @Failure 403 {string} errors.ErrNoPermission "no permission"
 // @Router /api/posts/{postId} [put]
 func (c *PostsController) EditPost(w http.ResponseWriter, r *http.Request) {
@@ -206,6 +308,18 @@ func (c *PostsController) EditPost(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	version, err := parseIfMatch(r)
+	if err != nil {
+		reqLogger.Error("Failed to parse If-Match header", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrPreconditionRequired):
+			http.Error(w, err.Error(), http.StatusPreconditionRequired)
+		default:
+			http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		}
+		return
+	}
+
 	var rows dto.EditPostRequest
 
 	err = json.NewDecoder(r.Body).Decode(&rows)
@@ -216,6 +330,7 @@ func (c *PostsController) EditPost(w http.ResponseWriter, r *http.Request) {
 	}
 	rows.PostId = r.PathValue("postId")
 	rows.AuthorId = user.UserId
+	rows.Version = version
 
 	response, err := c.srv.EditPost(&rows)
 	if err != nil {
@@ -223,11 +338,18 @@ func (c *PostsController) EditPost(w http.ResponseWriter, r *http.Request) {
 		switch {
 		case stderr.Is(err, errors.ErrPostNotFound):
 			http.Error(w, err.Error(), http.StatusNotFound)
+		case stderr.Is(err, errors.ErrInvalidLanguage), stderr.Is(err, errors.ErrInvalidContentFormat), stderr.Is(err, errors.ErrInvalidTags):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case stderr.Is(err, errors.ErrPostVersionMismatch):
+			http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		case stderr.Is(err, errors.ErrSlugAlreadyExists):
+			http.Error(w, err.Error(), http.StatusConflict)
 		default:
 			http.Error(w, err.Error(), http.StatusForbidden)
 		}
 		return
 	}
+	w.Header().Set("ETag", postETag(response.Version))
 	w.WriteHeader(http.StatusOK)
 	err = json.NewEncoder(w).Encode(response)
 	if err != nil {
@@ -239,6 +361,163 @@ func (c *PostsController) EditPost(w http.ResponseWriter, r *http.Request) {
 	reqLogger.Info("EditPost done")
 }
 
+// DeletePost godoc
+// @Summary Удалить пост (переместить в корзину)
+// @Tags Управление постами
+// @Accept json
+// @Produce json
+// @Param postId path string true "ID поста"
+// @Param Authorization header string true "Токен авторизации"
+// @Success 200 {object} dto.DeletePostResponse
+// @Failure 404 {string} errors.ErrPostNotFound "post not found"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/posts/{postId} [delete]
+func (c *PostsController) DeletePost(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "DeletePost"))
+
+	reqLogger.Info("Delete Post")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if user.Role != consts.AuthorRole {
+		reqLogger.Error("User have no permission", zap.Error(err))
+		http.Error(w, errors.ErrNoPermission.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.DeletePostRequest
+	rows.PostId = r.PathValue("postId")
+	rows.AuthorId = user.UserId
+
+	response, err := c.srv.DeletePost(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to delete post", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrPostNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	reqLogger.Info("DeletePost done")
+}
+
+// RestorePost godoc
+// @Summary Восстановить пост из корзины
+// @Tags Управление постами
+// @Accept json
+// @Produce json
+// @Param postId path string true "ID поста"
+// @Param Authorization header string true "Токен авторизации"
+// @Success 200 {object} dto.RestorePostResponse
+// @Failure 404 {string} errors.ErrPostNotFound "post not found"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/posts/{postId}/restore [post]
+func (c *PostsController) RestorePost(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "RestorePost"))
+
+	reqLogger.Info("Restore Post")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if user.Role != consts.AuthorRole {
+		reqLogger.Error("User have no permission", zap.Error(err))
+		http.Error(w, errors.ErrNoPermission.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.RestorePostRequest
+	rows.PostId = r.PathValue("postId")
+	rows.AuthorId = user.UserId
+
+	response, err := c.srv.RestorePost(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to restore post", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrPostNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	reqLogger.Info("RestorePost done")
+}
+
+// ViewTrash godoc
+// @Summary Просмотр корзины
+// @Tags Управление постами
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Токен авторизации"
+// @Success 200 {object} dto.GetPostsResponse
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/posts/trash [get]
+func (c *PostsController) ViewTrash(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewTrash"))
+
+	reqLogger.Info("View Trash")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if user.Role != consts.AuthorRole {
+		reqLogger.Error("User have no permission", zap.Error(err))
+		http.Error(w, errors.ErrNoPermission.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.GetTrashRequest
+	rows.AuthorId = user.UserId
+
+	response, err := c.srv.ViewTrash(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to view trash", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusForbidden)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("ViewTrash done")
+}
+
 // DeleteImageFromPost godoc
 // @Summary Удалить картинку из поста
 // @Tags Управление постами
@@ -297,16 +576,24 @@ func (c *PostsController) DeleteImageFromPost(w http.ResponseWriter, r *http.Req
 }
 
 // PublishPost godoc
-// @Summary Опубликовать пост
+// @Summary Изменить статус поста (Draft, Scheduled, Published, Archived)
+// @Description Для статуса Scheduled в поле publish_at передаётся время публикации в будущем. Публиковать и планировать посты могут только авторы с подтверждённой почтой
 // @Tags Управление постами
 // @Accept json
 // @Produce json
 // @Param postId path string true "ID поста"
 // @Param status body dto.PublishPostRequest true "Статус поста"
 // @Param Authorization header string true "Токен авторизации"
+// @Param If-Match header string true "ETag публикуемой версии поста или *"
 // @Success 200 {object} dto.PublishPostResponse
+// @Header 200 {string} ETag "Новая версия поста"
 // @Failure 400 {string} errors.ErrInvalidPostStatus "invalid post status"
+// @Failure 400 {string} errors.ErrInvalidPublishAt "invalid publish_at"
 // @Failure 404 {string} errors.ErrPostNotFound "post not found"
+// @Failure 409 {string} errors.ErrInvalidStatusTransition "invalid post status transition"
+// @Failure 412 {string} errors.ErrPostVersionMismatch "post version mismatch"
+// @Failure 428 {string} errors.ErrPreconditionRequired "if-match header required"
+// @Failure 403 {string} errors.ErrEmailNotVerified "email not verified"
 // @Failure 403 {string} errors.ErrNoPermission "no permission"
 // @Router /api/posts/{postId}/status [patch]
 func (c *PostsController) PublishPost(w http.ResponseWriter, r *http.Request) {
@@ -327,6 +614,18 @@ func (c *PostsController) PublishPost(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	version, err := parseIfMatch(r)
+	if err != nil {
+		reqLogger.Error("Failed to parse If-Match header", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrPreconditionRequired):
+			http.Error(w, err.Error(), http.StatusPreconditionRequired)
+		default:
+			http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		}
+		return
+	}
+
 	var rows dto.PublishPostRequest
 	err = json.NewDecoder(r.Body).Decode(&rows)
 	if err != nil {
@@ -336,20 +635,27 @@ func (c *PostsController) PublishPost(w http.ResponseWriter, r *http.Request) {
 	}
 	rows.PostId = r.PathValue("postId")
 	rows.AuthorId = user.UserId
+	rows.EmailVerified = user.EmailVerifiedAt != nil
+	rows.Version = version
 
 	response, err := c.srv.PublishPost(&rows)
 	if err != nil {
 		reqLogger.Error("Failed to publish post", zap.Error(err))
 		switch {
-		case stderr.Is(err, errors.ErrInvalidPostStatus):
+		case stderr.Is(err, errors.ErrInvalidPostStatus), stderr.Is(err, errors.ErrInvalidPublishAt):
 			http.Error(w, err.Error(), http.StatusBadRequest)
 		case stderr.Is(err, errors.ErrPostNotFound):
 			http.Error(w, err.Error(), http.StatusNotFound)
+		case stderr.Is(err, errors.ErrInvalidStatusTransition):
+			http.Error(w, err.Error(), http.StatusConflict)
+		case stderr.Is(err, errors.ErrPostVersionMismatch):
+			http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		default:
 			http.Error(w, err.Error(), http.StatusForbidden)
 		}
 		return
 	}
+	w.Header().Set("ETag", postETag(response.Version))
 	w.WriteHeader(http.StatusOK)
 	err = json.NewEncoder(w).Encode(response)
 	if err != nil {
@@ -360,13 +666,92 @@ func (c *PostsController) PublishPost(w http.ResponseWriter, r *http.Request) {
 	reqLogger.Info("PublishPost done")
 }
 
+// ViewPost godoc
+// @Summary Просмотр поста по ID или slug
+// @Description Доступен без авторизации: анонимные пользователи видят только опубликованные и архивные посты. Старый slug перенаправляет на актуальный.
+// @Tags Просмотр постов
+// @Accept json
+// @Produce json
+// @Param postIdOrSlug path string true "ID или slug поста"
+// @Param Authorization header string false "Токен авторизации"
+// @Param If-None-Match header string false "ETag закэшированной версии поста"
+// @Success 200 {object} dto.GetPostResponse
+// @Header 200 {string} ETag "Версия поста и хэш ответа, принимается в If-Match"
+// @Success 301 "Пост доступен по новому slug"
+// @Success 304 "Пост не изменился"
+// @Failure 404 {string} errors.ErrPostNotFound "post not found"
+// @Router /api/posts/{postIdOrSlug} [get]
+func (c *PostsController) ViewPost(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewPost"))
+
+	reqLogger.Info("View Post")
+
+	var rows dto.GetPostRequest
+	rows.PostIdOrSlug = r.PathValue("postIdOrSlug")
+	if user, err := getUserFromCtx(r); err == nil {
+		rows.UserId = user.UserId
+	}
+
+	response, err := c.srv.ViewPost(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to view post", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrPostNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	if response.Redirect != "" {
+		w.Header().Set("Location", url.PathEscape(response.Redirect))
+		w.WriteHeader(http.StatusMovedPermanently)
+		reqLogger.Info("ViewPost done")
+		return
+	}
+
+	var body bytes.Buffer
+	err = json.NewEncoder(&body).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	etag := postBodyETag(response.Post.Version, body.Bytes())
+	w.Header().Set("ETag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		reqLogger.Info("ViewPost done")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if _, err = body.WriteTo(w); err != nil {
+		reqLogger.Error("Failed to write response", zap.Error(err))
+		return
+	}
+
+	reqLogger.Info("ViewPost done")
+}
+
 // ViewPosts godoc
 // @Summary Просмотр постов
 // @Tags Просмотр постов
 // @Accept json
 // @Produce json
-// @Param Authorization header string true "Токен авторизации"
+// @Param Authorization header string false "Токен авторизации"
+// @Param limit query int false "Количество постов на странице (1-100)"
+// @Param cursor query string false "Курсор следующей страницы"
+// @Param sort query string false "Поле сортировки (created_at, updated_at)"
+// @Param order query string false "Порядок сортировки (asc, desc)"
+// @Param status query string false "Статус поста"
+// @Param author query string false "ID автора"
+// @Param from query string false "Начало периода создания (RFC 3339)"
+// @Param to query string false "Конец периода создания (RFC 3339)"
 // @Success 200 {object} dto.GetPostsResponse
+// @Failure 400 {string} errors.ErrInvalidQueryParams "invalid query params"
 // @Router /api/posts [get]
 func (c *PostsController) ViewPosts(w http.ResponseWriter, r *http.Request) {
 	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewPosts"))
@@ -375,8 +760,8 @@ func (c *PostsController) ViewPosts(w http.ResponseWriter, r *http.Request) {
 
 	user, err := getUserFromCtx(r)
 	if err != nil {
-		reqLogger.Error("Failed to get user from context", zap.Error(err))
-		http.Error(w, err.Error(), http.StatusForbidden)
+		c.ReaderView(w, r)
+		reqLogger.Info("ViewPosts done")
 		return
 	}
 
@@ -405,13 +790,26 @@ func (c *PostsController) AuthorView(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusForbidden)
 	}
 
+	query, err := parsePostsQuery(r)
+	if err != nil {
+		reqLogger.Error("Failed to parse query", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	var posts dto.GetPostsByIdRequest
 
 	posts.AuthorId = user.UserId
+	posts.PostsQuery = query
 	response, err := c.srv.ViewPostsById(&posts)
 	if err != nil {
 		reqLogger.Error("Failed to view posts", zap.Error(err))
-		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusForbidden)
+		switch {
+		case isBadQueryError(err):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, errors.ErrInternalServerError.Error(), http.StatusForbidden)
+		}
 		return
 	}
 
@@ -430,10 +828,25 @@ func (c *PostsController) ReaderView(w http.ResponseWriter, r *http.Request) {
 
 	reqLogger.Info("Reader View")
 
-	response, err := c.srv.ViewAllPosts()
+	query, err := parsePostsQuery(r)
+	if err != nil {
+		reqLogger.Error("Failed to parse query", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var posts dto.GetAllPostsRequest
+
+	posts.PostsQuery = query
+	response, err := c.srv.ViewAllPosts(&posts)
 	if err != nil {
 		reqLogger.Error("Failed to view posts", zap.Error(err))
-		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusForbidden)
+		switch {
+		case isBadQueryError(err):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, errors.ErrInternalServerError.Error(), http.StatusForbidden)
+		}
 		return
 	}
 
@@ -446,3 +859,60 @@ func (c *PostsController) ReaderView(w http.ResponseWriter, r *http.Request) {
 	}
 	reqLogger.Info("ReaderView done")
 }
+
+// SearchPosts godoc
+// @Summary Полнотекстовый поиск по опубликованным постам
+// @Description Поле headline содержит экранированный текст поста, совпадения выделены тегом <mark>
+// @Tags Просмотр постов
+// @Accept json
+// @Produce json
+// @Param q query string true "Поисковый запрос"
+// @Param lang query string false "Язык поиска (simple, english, russian)"
+// @Param limit query int false "Количество результатов на странице (1-100)"
+// @Param offset query int false "Смещение"
+// @Success 200 {object} dto.SearchPostsResponse
+// @Failure 400 {string} errors.ErrInvalidQueryParams "invalid query params"
+// @Router /api/posts/search [get]
+func (c *PostsController) SearchPosts(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "SearchPosts"))
+
+	reqLogger.Info("Search Posts")
+
+	values := r.URL.Query()
+
+	var rows dto.SearchPostsRequest
+	rows.Query = values.Get("q")
+	rows.Language = values.Get("lang")
+	for param, target := range map[string]*int{"limit": &rows.Limit, "offset": &rows.Offset} {
+		if raw := values.Get(param); raw != "" {
+			value, err := strconv.Atoi(raw)
+			if err != nil {
+				reqLogger.Error("Failed to parse query", zap.Error(err))
+				http.Error(w, errors.ErrInvalidQueryParams.Error(), http.StatusBadRequest)
+				return
+			}
+			*target = value
+		}
+	}
+
+	response, err := c.srv.SearchPosts(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to search posts", zap.Error(err))
+		switch {
+		case isBadQueryError(err):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, errors.ErrInternalServerError.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("SearchPosts done")
+}
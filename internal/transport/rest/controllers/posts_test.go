@@ -46,6 +46,46 @@ func (m *MockPostsService) PublishPost(post *dto.PublishPostRequest) (*dto.Publi
 	return args.Get(0).(*dto.PublishPostResponse), args.Error(1)
 }
 
+func (m *MockPostsService) DeletePost(rows *dto.DeletePostRequest) (*dto.DeletePostResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.DeletePostResponse), args.Error(1)
+}
+
+func (m *MockPostsService) RestorePost(rows *dto.RestorePostRequest) (*dto.RestorePostResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.RestorePostResponse), args.Error(1)
+}
+
+func (m *MockPostsService) ViewTrash(rows *dto.GetTrashRequest) (*dto.GetPostsResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetPostsResponse), args.Error(1)
+}
+
+func (m *MockPostsService) ViewPost(rows *dto.GetPostRequest) (*dto.GetPostResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetPostResponse), args.Error(1)
+}
+
+func (m *MockPostsService) ViewStatusHistory(rows *dto.GetStatusHistoryRequest) (*dto.GetStatusHistoryResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetStatusHistoryResponse), args.Error(1)
+}
+
 func (m *MockPostsService) ViewPostsById(rows *dto.GetPostsByIdRequest) (*dto.GetPostsResponse, error) {
 	args := m.Called(rows)
 	if args.Get(0) == nil {
@@ -54,14 +94,54 @@ func (m *MockPostsService) ViewPostsById(rows *dto.GetPostsByIdRequest) (*dto.Ge
 	return args.Get(0).(*dto.GetPostsResponse), args.Error(1)
 }
 
-func (m *MockPostsService) ViewAllPosts() (*dto.GetPostsResponse, error) {
-	args := m.Called()
+func (m *MockPostsService) ViewAllPosts(rows *dto.GetAllPostsRequest) (*dto.GetPostsResponse, error) {
+	args := m.Called(rows)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*dto.GetPostsResponse), args.Error(1)
 }
 
+func (m *MockPostsService) SearchPosts(rows *dto.SearchPostsRequest) (*dto.SearchPostsResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.SearchPostsResponse), args.Error(1)
+}
+
+func (m *MockPostsService) ViewRevisions(rows *dto.GetRevisionsRequest) (*dto.GetRevisionsResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetRevisionsResponse), args.Error(1)
+}
+
+func (m *MockPostsService) ViewRevision(rows *dto.GetRevisionRequest) (*dto.GetRevisionResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetRevisionResponse), args.Error(1)
+}
+
+func (m *MockPostsService) DiffRevisions(rows *dto.DiffRevisionsRequest) (*dto.DiffRevisionsResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.DiffRevisionsResponse), args.Error(1)
+}
+
+func (m *MockPostsService) RestoreRevision(rows *dto.RestoreRevisionRequest) (*dto.RestoreRevisionResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.RestoreRevisionResponse), args.Error(1)
+}
+
 func (m *MockPostsService) AddImage(rows *dto.AddImageToPostRequest) (*dto.AddImageToPostResponse, error) {
 	args := m.Called(rows)
 	if args.Get(0) == nil {
@@ -159,6 +239,23 @@ func TestPostsController_CreatePost(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusConflict,
 		},
+		{
+			name: "invalid content format",
+			requestBody: &dto.CreatePostRequest{
+				AuthorId:       "authorId",
+				IdempotencyKey: "idempotencyKey",
+				Title:          "title",
+				Content:        "content",
+				ContentFormat:  "html",
+			},
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("CreatePost", mock.AnythingOfType("*dto.CreatePostRequest")).
+					Return(nil, errors.ErrInvalidContentFormat)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
 		{
 			name: "internal server error",
 			requestBody: &dto.CreatePostRequest{
@@ -219,6 +316,8 @@ func TestPostsController_EditPost(t *testing.T) {
 	tests := []struct {
 		name               string
 		requestBody        interface{}
+		ifMatch            string
+		noIfMatch          bool
 		role               string
 		key                string
 		mockFunc           func(m *MockPostsService)
@@ -316,6 +415,23 @@ func TestPostsController_EditPost(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusForbidden,
 		},
+		{
+			name: "invalid content format",
+			requestBody: &dto.EditPostRequest{
+				PostId:        postId,
+				AuthorId:      "authorId",
+				Title:         "title",
+				Content:       "content",
+				ContentFormat: "html",
+			},
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("EditPost", mock.AnythingOfType("*dto.EditPostRequest")).
+					Return(nil, errors.ErrInvalidContentFormat)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
 		{
 			name: "invalid post postId",
 			requestBody: &dto.EditPostRequest{
@@ -348,6 +464,36 @@ func TestPostsController_EditPost(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusForbidden,
 		},
+		{
+			name:               "missing if-match",
+			requestBody:        &dto.EditPostRequest{},
+			noIfMatch:          true,
+			role:               consts.AuthorRole,
+			key:                consts.CtxUserKey,
+			expectedStatusCode: http.StatusPreconditionRequired,
+		},
+		{
+			name:               "malformed if-match",
+			requestBody:        &dto.EditPostRequest{},
+			ifMatch:            "1",
+			role:               consts.AuthorRole,
+			key:                consts.CtxUserKey,
+			expectedStatusCode: http.StatusPreconditionFailed,
+		},
+		{
+			name:        "stale version",
+			requestBody: &dto.EditPostRequest{},
+			ifMatch:     `"1"`,
+			role:        consts.AuthorRole,
+			key:         consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("EditPost", mock.MatchedBy(func(rows *dto.EditPostRequest) bool {
+					return rows.Version == 1
+				})).
+					Return(nil, errors.ErrPostVersionMismatch)
+			},
+			expectedStatusCode: http.StatusPreconditionFailed,
+		},
 	}
 
 	for _, test := range tests {
@@ -368,6 +514,13 @@ func TestPostsController_EditPost(t *testing.T) {
 				req = httptest.NewRequest(http.MethodPut, "/api/posts/"+postId, nil)
 			}
 			req.Header.Set("Content-Type", "application/json")
+			if !test.noIfMatch {
+				ifMatch := test.ifMatch
+				if ifMatch == "" {
+					ifMatch = postETag(1)
+				}
+				req.Header.Set("If-Match", ifMatch)
+			}
 
 			ctx := context.WithValue(req.Context(), test.key, &entities.User{
 				Role: test.role,
@@ -393,6 +546,8 @@ func TestPostsController_PublishPost(t *testing.T) {
 	tests := []struct {
 		name               string
 		requestBody        interface{}
+		ifMatch            string
+		noIfMatch          bool
 		role               string
 		key                string
 		mockFunc           func(m *MockPostsService)
@@ -526,6 +681,77 @@ func TestPostsController_PublishPost(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusForbidden,
 		},
+		{
+			name: "email not verified",
+			requestBody: &dto.PublishPostRequest{
+				PostId:   postId,
+				AuthorId: "authorId",
+				Status:   consts.PublishedState,
+			},
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("PublishPost", mock.AnythingOfType("*dto.PublishPostRequest")).
+					Return(nil, errors.ErrEmailNotVerified)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "invalid publish_at",
+			requestBody: &dto.PublishPostRequest{
+				Status: consts.ScheduledState,
+			},
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("PublishPost", mock.AnythingOfType("*dto.PublishPostRequest")).
+					Return(nil, errors.ErrInvalidPublishAt)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "invalid status transition",
+			requestBody: &dto.PublishPostRequest{
+				Status: consts.ArchivedState,
+			},
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("PublishPost", mock.AnythingOfType("*dto.PublishPostRequest")).
+					Return(nil, errors.ErrInvalidStatusTransition)
+			},
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name:               "missing if-match",
+			requestBody:        &dto.PublishPostRequest{},
+			noIfMatch:          true,
+			role:               consts.AuthorRole,
+			key:                consts.CtxUserKey,
+			expectedStatusCode: http.StatusPreconditionRequired,
+		},
+		{
+			name:               "malformed if-match",
+			requestBody:        &dto.PublishPostRequest{},
+			ifMatch:            "1",
+			role:               consts.AuthorRole,
+			key:                consts.CtxUserKey,
+			expectedStatusCode: http.StatusPreconditionFailed,
+		},
+		{
+			name:        "stale version",
+			requestBody: &dto.PublishPostRequest{},
+			ifMatch:     `"1"`,
+			role:        consts.AuthorRole,
+			key:         consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("PublishPost", mock.MatchedBy(func(rows *dto.PublishPostRequest) bool {
+					return rows.Version == 1
+				})).
+					Return(nil, errors.ErrPostVersionMismatch)
+			},
+			expectedStatusCode: http.StatusPreconditionFailed,
+		},
 	}
 
 	for _, test := range tests {
@@ -546,6 +772,13 @@ func TestPostsController_PublishPost(t *testing.T) {
 				req = httptest.NewRequest(http.MethodPatch, "/api/posts/"+postId+"/status", nil)
 			}
 			req.Header.Set("Content-Type", "application/json")
+			if !test.noIfMatch {
+				ifMatch := test.ifMatch
+				if ifMatch == "" {
+					ifMatch = postETag(1)
+				}
+				req.Header.Set("If-Match", ifMatch)
+			}
 
 			ctx := context.WithValue(req.Context(), test.key, &entities.User{
 				Role: test.role,
@@ -903,7 +1136,9 @@ func TestPostsController_DeleteImageFromPost(t *testing.T) {
 	}
 }
 
-func TestPostsController_ViewPosts(t *testing.T) {
+func TestPostsController_DeletePost(t *testing.T) {
+	postId := uuid.New().String()
+
 	tests := []struct {
 		name               string
 		role               string
@@ -913,39 +1148,21 @@ func TestPostsController_ViewPosts(t *testing.T) {
 		checkResponseBody  func(t *testing.T, responseBody string)
 	}{
 		{
-			name: "successful author view",
+			name: "successful",
 			role: consts.AuthorRole,
 			key:  consts.CtxUserKey,
 			mockFunc: func(m *MockPostsService) {
-				m.On("ViewPostsById", mock.AnythingOfType("*dto.GetPostsByIdRequest")).
-					Return(&dto.GetPostsResponse{
-						Posts: make([]entities.Post, 1),
-					}, nil)
-			},
-			expectedStatusCode: http.StatusOK,
-			checkResponseBody: func(t *testing.T, responseBody string) {
-				var response dto.GetPostsResponse
-				err := json.Unmarshal([]byte(responseBody), &response)
-				assert.NoError(t, err)
-				assert.Equal(t, 1, len(response.Posts))
-			},
-		},
-		{
-			name: "successful reader view",
-			role: consts.ReaderRole,
-			key:  consts.CtxUserKey,
-			mockFunc: func(m *MockPostsService) {
-				m.On("ViewAllPosts").
-					Return(&dto.GetPostsResponse{
-						Posts: make([]entities.Post, 1),
+				m.On("DeletePost", mock.AnythingOfType("*dto.DeletePostRequest")).
+					Return(&dto.DeletePostResponse{
+						Message: "message",
 					}, nil)
 			},
 			expectedStatusCode: http.StatusOK,
 			checkResponseBody: func(t *testing.T, responseBody string) {
-				var response dto.GetPostsResponse
+				var response dto.DeletePostResponse
 				err := json.Unmarshal([]byte(responseBody), &response)
 				assert.NoError(t, err)
-				assert.Equal(t, 1, len(response.Posts))
+				assert.NotEmpty(t, response.Message)
 			},
 		},
 		{
@@ -956,32 +1173,41 @@ func TestPostsController_ViewPosts(t *testing.T) {
 		},
 		{
 			name:               "no permission",
-			role:               "testRole",
+			role:               consts.ReaderRole,
 			key:                consts.CtxUserKey,
 			expectedStatusCode: http.StatusForbidden,
 		},
 		{
-			name: "invalid post id",
+			name: "post not found",
 			role: consts.AuthorRole,
 			key:  consts.CtxUserKey,
 			mockFunc: func(m *MockPostsService) {
-				m.On("ViewPostsById", mock.AnythingOfType("*dto.GetPostsByIdRequest")).
-					Return(nil, errors.ErrInvalidPostId)
+				m.On("DeletePost", mock.AnythingOfType("*dto.DeletePostRequest")).
+					Return(nil, errors.ErrPostNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "not post author",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("DeletePost", mock.AnythingOfType("*dto.DeletePostRequest")).
+					Return(nil, errors.ErrNoPermission)
 			},
 			expectedStatusCode: http.StatusForbidden,
 		},
 		{
-			name: "internal server error",
+			name: "minio cant remove object",
 			role: consts.AuthorRole,
 			key:  consts.CtxUserKey,
 			mockFunc: func(m *MockPostsService) {
-				m.On("ViewPostsById", mock.AnythingOfType("*dto.GetPostsByIdRequest")).
-					Return(nil, errors.ErrInternalServerError)
+				m.On("DeletePost", mock.AnythingOfType("*dto.DeletePostRequest")).
+					Return(nil, errors.ErrMinioRemoveObject)
 			},
 			expectedStatusCode: http.StatusForbidden,
 		},
 	}
-
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			mockPostsService := &MockPostsService{}
@@ -991,7 +1217,7 @@ func TestPostsController_ViewPosts(t *testing.T) {
 
 			controller := NewPostsController(mockPostsService)
 
-			req := httptest.NewRequest(http.MethodGet, "/api/posts", nil)
+			req := httptest.NewRequest(http.MethodDelete, "/api/posts/"+postId, nil)
 
 			req.Header.Set("Content-Type", "application/json")
 
@@ -1000,7 +1226,604 @@ func TestPostsController_ViewPosts(t *testing.T) {
 			})
 
 			rr := httptest.NewRecorder()
-			controller.ViewPosts(rr, req.WithContext(ctx))
+			controller.DeletePost(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockPostsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPostsController_RestorePost(t *testing.T) {
+	postId := uuid.New().String()
+
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockPostsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("RestorePost", mock.AnythingOfType("*dto.RestorePostRequest")).
+					Return(&dto.RestorePostResponse{
+						Message: "message",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.RestorePostResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.NotEmpty(t, response.Message)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.AuthorRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "no permission",
+			role:               consts.ReaderRole,
+			key:                consts.CtxUserKey,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "post not found",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("RestorePost", mock.AnythingOfType("*dto.RestorePostRequest")).
+					Return(nil, errors.ErrPostNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "not post author",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("RestorePost", mock.AnythingOfType("*dto.RestorePostRequest")).
+					Return(nil, errors.ErrNoPermission)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockPostsService := &MockPostsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockPostsService)
+			}
+
+			controller := NewPostsController(mockPostsService)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/posts/"+postId+"/restore", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.RestorePost(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockPostsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPostsController_ViewTrash(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockPostsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewTrash", mock.AnythingOfType("*dto.GetTrashRequest")).
+					Return(&dto.GetPostsResponse{
+						Posts: make([]entities.Post, 1),
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetPostsResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, len(response.Posts))
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.AuthorRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "no permission",
+			role:               consts.ReaderRole,
+			key:                consts.CtxUserKey,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "internal server error",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewTrash", mock.AnythingOfType("*dto.GetTrashRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockPostsService := &MockPostsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockPostsService)
+			}
+
+			controller := NewPostsController(mockPostsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/posts/trash", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewTrash(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockPostsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPostsController_ViewPost(t *testing.T) {
+	postId := uuid.New().String()
+	post := &dto.GetPostResponse{
+		Post: entities.Post{PostId: postId, Version: 3, CommentsCount: 1},
+	}
+	commented := &dto.GetPostResponse{
+		Post: entities.Post{PostId: postId, Version: 3, CommentsCount: 2},
+	}
+
+	tests := []struct {
+		name               string
+		ifNoneMatch        string
+		role               string
+		key                string
+		mockFunc           func(m *MockPostsService)
+		expectedStatusCode int
+		expectedETag       string
+		expectedLocation   string
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewPost", mock.AnythingOfType("*dto.GetPostRequest")).
+					Return(post, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedETag:       viewPostETag(post),
+		},
+		{
+			name:        "not modified",
+			ifNoneMatch: viewPostETag(post),
+			role:        consts.ReaderRole,
+			key:         consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewPost", mock.AnythingOfType("*dto.GetPostRequest")).
+					Return(post, nil)
+			},
+			expectedStatusCode: http.StatusNotModified,
+			expectedETag:       viewPostETag(post),
+		},
+		{
+			name:        "same version with new comments",
+			ifNoneMatch: viewPostETag(post),
+			role:        consts.ReaderRole,
+			key:         consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewPost", mock.AnythingOfType("*dto.GetPostRequest")).
+					Return(commented, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedETag:       viewPostETag(commented),
+		},
+		{
+			name: "anonymous",
+			role: consts.ReaderRole,
+			key:  "testKey",
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewPost", mock.MatchedBy(func(rows *dto.GetPostRequest) bool {
+					return rows.UserId == ""
+				})).
+					Return(post, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedETag:       viewPostETag(post),
+		},
+		{
+			name: "old slug",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewPost", mock.AnythingOfType("*dto.GetPostRequest")).
+					Return(&dto.GetPostResponse{
+						Post:     entities.Post{PostId: postId, Slug: "new-title", Version: 2},
+						Redirect: "new-title",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusMovedPermanently,
+			expectedLocation:   "new-title",
+		},
+		{
+			name: "post not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewPost", mock.AnythingOfType("*dto.GetPostRequest")).
+					Return(nil, errors.ErrPostNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockPostsService := &MockPostsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockPostsService)
+			}
+
+			controller := NewPostsController(mockPostsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/posts/"+postId, nil)
+			req.Header.Set("Content-Type", "application/json")
+			if test.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", test.ifNoneMatch)
+			}
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewPost(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+			assert.Equal(t, test.expectedETag, rr.Header().Get("ETag"))
+			assert.Equal(t, test.expectedLocation, rr.Header().Get("Location"))
+
+			mockPostsService.AssertExpectations(t)
+		})
+	}
+}
+
+func viewPostETag(response *dto.GetPostResponse) string {
+	body, _ := json.Marshal(response)
+	return postBodyETag(response.Post.Version, append(body, '\n'))
+}
+
+func TestParseIfMatch(t *testing.T) {
+	tests := []struct {
+		name            string
+		ifMatch         string
+		expectedVersion int
+		expectedErr     error
+	}{
+		{name: "version", ifMatch: postETag(3), expectedVersion: 3},
+		{name: "view etag", ifMatch: postBodyETag(3, []byte("body")), expectedVersion: 3},
+		{name: "weak", ifMatch: "W/" + postETag(3), expectedVersion: 3},
+		{name: "weak view etag", ifMatch: "W/" + postBodyETag(3, []byte("body")), expectedVersion: 3},
+		{name: "any", ifMatch: "*", expectedVersion: consts.AnyPostVersion},
+		{name: "missing", expectedErr: errors.ErrPreconditionRequired},
+		{name: "unquoted", ifMatch: "3", expectedErr: errors.ErrPostVersionMismatch},
+		{name: "not a version", ifMatch: `"abc"`, expectedErr: errors.ErrPostVersionMismatch},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPatch, "/api/posts/postId", nil)
+			if test.ifMatch != "" {
+				req.Header.Set("If-Match", test.ifMatch)
+			}
+
+			version, err := parseIfMatch(req)
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expectedVersion, version)
+		})
+	}
+}
+
+func TestPostsController_ViewPosts(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		query              string
+		mockFunc           func(m *MockPostsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful author view",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewPostsById", mock.AnythingOfType("*dto.GetPostsByIdRequest")).
+					Return(&dto.GetPostsResponse{
+						Posts: make([]entities.Post, 1),
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetPostsResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, len(response.Posts))
+			},
+		},
+		{
+			name: "successful reader view",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewAllPosts", mock.AnythingOfType("*dto.GetAllPostsRequest")).
+					Return(&dto.GetPostsResponse{
+						Posts: make([]entities.Post, 1),
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetPostsResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, len(response.Posts))
+			},
+		},
+		{
+			name:  "pagination params",
+			role:  consts.ReaderRole,
+			key:   consts.CtxUserKey,
+			query: "limit=10&cursor=abc&sort=updated_at&order=asc&author=authorId&from=2024-01-01T00:00:00Z",
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewAllPosts", mock.MatchedBy(func(rows *dto.GetAllPostsRequest) bool {
+					return rows.Limit == 10 && rows.Cursor == "abc" && rows.Sort == consts.SortByUpdatedAt &&
+						rows.Order == consts.OrderAsc && rows.Author == "authorId" && rows.From != nil && rows.To == nil
+				})).
+					Return(&dto.GetPostsResponse{
+						Posts:      make([]entities.Post, 1),
+						NextCursor: "next",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetPostsResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "next", response.NextCursor)
+			},
+		},
+		{
+			name:               "invalid limit",
+			role:               consts.AuthorRole,
+			key:                consts.CtxUserKey,
+			query:              "limit=abc",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "invalid date range",
+			role:               consts.ReaderRole,
+			key:                consts.CtxUserKey,
+			query:              "from=yesterday",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:  "invalid cursor",
+			role:  consts.AuthorRole,
+			key:   consts.CtxUserKey,
+			query: "cursor=abc",
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewPostsById", mock.AnythingOfType("*dto.GetPostsByIdRequest")).
+					Return(nil, errors.ErrInvalidCursor)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "anonymous reader view",
+			role: consts.AuthorRole,
+			key:  "testKey",
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewAllPosts", mock.AnythingOfType("*dto.GetAllPostsRequest")).
+					Return(&dto.GetPostsResponse{}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "no permission",
+			role:               "testRole",
+			key:                consts.CtxUserKey,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "invalid post id",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewPostsById", mock.AnythingOfType("*dto.GetPostsByIdRequest")).
+					Return(nil, errors.ErrInvalidPostId)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "internal server error",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewPostsById", mock.AnythingOfType("*dto.GetPostsByIdRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockPostsService := &MockPostsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockPostsService)
+			}
+
+			controller := NewPostsController(mockPostsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/posts?"+test.query, nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewPosts(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockPostsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPostsController_SearchPosts(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockPostsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("SearchPosts", mock.AnythingOfType("*dto.SearchPostsRequest")).
+					Return(&dto.SearchPostsResponse{
+						Results:    make([]entities.SearchResult, 1),
+						NextOffset: 20,
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.SearchPostsResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, len(response.Results))
+				assert.Equal(t, 20, response.NextOffset)
+			},
+		},
+		{
+			name: "anonymous",
+			role: consts.ReaderRole,
+			key:  "testKey",
+			mockFunc: func(m *MockPostsService) {
+				m.On("SearchPosts", mock.AnythingOfType("*dto.SearchPostsRequest")).
+					Return(&dto.SearchPostsResponse{}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "empty query",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("SearchPosts", mock.AnythingOfType("*dto.SearchPostsRequest")).
+					Return(nil, errors.ErrInvalidQueryParams)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "invalid language",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("SearchPosts", mock.AnythingOfType("*dto.SearchPostsRequest")).
+					Return(nil, errors.ErrInvalidLanguage)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("SearchPosts", mock.AnythingOfType("*dto.SearchPostsRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockPostsService := &MockPostsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockPostsService)
+			}
+
+			controller := NewPostsController(mockPostsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/posts/search?q=golang&lang=english&limit=20", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.SearchPosts(rr, req.WithContext(ctx))
 
 			assert.Equal(t, test.expectedStatusCode, rr.Code)
 
@@ -0,0 +1,143 @@
+package controllers
+
+import (
+	"blog/internal/logger"
+	"blog/internal/models/dto"
+	"blog/pkg/consts/errors"
+	"encoding/json"
+	stderr "errors"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+)
+
+type ReactionsService interface {
+	ToggleReaction(rows *dto.ToggleReactionRequest) (*dto.ToggleReactionResponse, error)
+	ViewReactedPosts(rows *dto.GetReactedPostsRequest) (*dto.GetReactedPostsResponse, error)
+}
+
+type ReactionsController struct {
+	srv ReactionsService
+}
+
+func NewReactionsController(srv ReactionsService) *ReactionsController {
+	return &ReactionsController{
+		srv: srv,
+	}
+}
+
+// ToggleReaction godoc
+// @Summary Поставить или снять реакцию на пост
+// @Tags Реакции
+// @Accept json
+// @Produce json
+// @Param postId path string true "ID поста"
+// @Param reaction path string true "Реакция (like, heart, laugh, wow, sad, fire)"
+// @Param Authorization header string true "Токен авторизации"
+// @Success 200 {object} dto.ToggleReactionResponse
+// @Failure 400 {string} errors.ErrInvalidReaction "invalid reaction"
+// @Failure 404 {string} errors.ErrPostNotFound "post not found"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/posts/{postId}/reactions/{reaction} [post]
+func (c *ReactionsController) ToggleReaction(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ToggleReaction"))
+
+	reqLogger.Info("Toggle Reaction")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.ToggleReactionRequest
+	rows.UserId = user.UserId
+	rows.PostId = r.PathValue("postId")
+	rows.Reaction = r.PathValue("reaction")
+
+	response, err := c.srv.ToggleReaction(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to toggle reaction", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrPostNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case stderr.Is(err, errors.ErrInvalidReaction):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("ToggleReaction done")
+}
+
+// ViewReactedPosts godoc
+// @Summary Посты, на которые пользователь поставил реакции
+// @Tags Реакции
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Токен авторизации"
+// @Param limit query int false "Количество постов на странице (1-100)"
+// @Param cursor query string false "Курсор следующей страницы"
+// @Success 200 {object} dto.GetReactedPostsResponse
+// @Failure 400 {string} errors.ErrInvalidQueryParams "invalid query params"
+// @Failure 400 {string} errors.ErrInvalidCursor "invalid cursor"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/users/me/reactions [get]
+func (c *ReactionsController) ViewReactedPosts(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewReactedPosts"))
+
+	reqLogger.Info("View Reacted Posts")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.GetReactedPostsRequest
+	rows.UserId = user.UserId
+	rows.Cursor = r.URL.Query().Get("cursor")
+
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil {
+			reqLogger.Error("Failed to parse query", zap.Error(err))
+			http.Error(w, errors.ErrInvalidQueryParams.Error(), http.StatusBadRequest)
+			return
+		}
+		rows.Limit = value
+	}
+
+	response, err := c.srv.ViewReactedPosts(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to view reacted posts", zap.Error(err))
+		switch {
+		case isBadQueryError(err):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("ViewReactedPosts done")
+}
@@ -0,0 +1,219 @@
+package controllers
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockReactionsService struct {
+	mock.Mock
+}
+
+func (m *MockReactionsService) ToggleReaction(rows *dto.ToggleReactionRequest) (*dto.ToggleReactionResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ToggleReactionResponse), args.Error(1)
+}
+
+func (m *MockReactionsService) ViewReactedPosts(rows *dto.GetReactedPostsRequest) (*dto.GetReactedPostsResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetReactedPostsResponse), args.Error(1)
+}
+
+func TestReactionsController_ToggleReaction(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockReactionsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReactionsService) {
+				m.On("ToggleReaction", mock.AnythingOfType("*dto.ToggleReactionRequest")).
+					Return(&dto.ToggleReactionResponse{
+						Message:   "message",
+						Reacted:   true,
+						Reactions: map[string]int{consts.LikeReaction: 1},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.ToggleReactionResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.True(t, response.Reacted)
+				assert.Equal(t, 1, response.Reactions[consts.LikeReaction])
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.ReaderRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "post not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReactionsService) {
+				m.On("ToggleReaction", mock.AnythingOfType("*dto.ToggleReactionRequest")).
+					Return(nil, errors.ErrPostNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "invalid reaction",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReactionsService) {
+				m.On("ToggleReaction", mock.AnythingOfType("*dto.ToggleReactionRequest")).
+					Return(nil, errors.ErrInvalidReaction)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReactionsService) {
+				m.On("ToggleReaction", mock.AnythingOfType("*dto.ToggleReactionRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockReactionsService := &MockReactionsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockReactionsService)
+			}
+
+			controller := NewReactionsController(mockReactionsService)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/posts/postId/reactions/like", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ToggleReaction(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockReactionsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReactionsController_ViewReactedPosts(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockReactionsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReactionsService) {
+				m.On("ViewReactedPosts", mock.AnythingOfType("*dto.GetReactedPostsRequest")).
+					Return(&dto.GetReactedPostsResponse{
+						Posts: []entities.ReactedPost{{Post: entities.Post{PostId: "postId"}, Reactions: []string{consts.LikeReaction}}},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetReactedPostsResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, []string{consts.LikeReaction}, response.Posts[0].Reactions)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.ReaderRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "invalid cursor",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReactionsService) {
+				m.On("ViewReactedPosts", mock.AnythingOfType("*dto.GetReactedPostsRequest")).
+					Return(nil, errors.ErrInvalidCursor)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReactionsService) {
+				m.On("ViewReactedPosts", mock.AnythingOfType("*dto.GetReactedPostsRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockReactionsService := &MockReactionsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockReactionsService)
+			}
+
+			controller := NewReactionsController(mockReactionsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/users/me/reactions?limit=10", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewReactedPosts(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockReactionsService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,411 @@
+package controllers
+
+import (
+	"blog/internal/logger"
+	"blog/internal/models/dto"
+	"blog/pkg/consts/errors"
+	"encoding/json"
+	stderr "errors"
+	"io"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+type ReadingListsService interface {
+	CreateReadingList(rows *dto.CreateReadingListRequest) (*dto.CreateReadingListResponse, error)
+	ViewReadingLists(rows *dto.GetReadingListsRequest) (*dto.GetReadingListsResponse, error)
+	ViewReadingList(rows *dto.GetReadingListRequest) (*dto.GetReadingListResponse, error)
+	RenameReadingList(rows *dto.RenameReadingListRequest) (*dto.RenameReadingListResponse, error)
+	DeleteReadingList(rows *dto.DeleteReadingListRequest) (*dto.DeleteReadingListResponse, error)
+	AddToReadingList(rows *dto.AddToReadingListRequest) (*dto.AddToReadingListResponse, error)
+	RemoveFromReadingList(rows *dto.RemoveFromReadingListRequest) (*dto.RemoveFromReadingListResponse, error)
+}
+
+type ReadingListsController struct {
+	srv ReadingListsService
+}
+
+func NewReadingListsController(srv ReadingListsService) *ReadingListsController {
+	return &ReadingListsController{
+		srv: srv,
+	}
+}
+
+// CreateReadingList godoc
+// @Summary Создать список для чтения
+// @Tags Списки для чтения
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Токен авторизации"
+// @Param request body dto.CreateReadingListRequest true "Название списка"
+// @Success 201 {object} dto.CreateReadingListResponse
+// @Failure 400 {string} errors.ErrInvalidReadingListName "invalid reading list name"
+// @Failure 409 {string} errors.ErrReadingListAlreadyExists "reading list already exists"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/users/me/lists [post]
+func (c *ReadingListsController) CreateReadingList(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "CreateReadingList"))
+
+	reqLogger.Info("Create Reading List")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.CreateReadingListRequest
+	err = json.NewDecoder(r.Body).Decode(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to decode request body", zap.Error(err))
+		http.Error(w, errors.ErrIncorrectData.Error(), http.StatusBadRequest)
+		return
+	}
+	rows.UserId = user.UserId
+
+	response, err := c.srv.CreateReadingList(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to create reading list", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrInvalidReadingListName):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case stderr.Is(err, errors.ErrReadingListAlreadyExists):
+			http.Error(w, err.Error(), http.StatusConflict)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("CreateReadingList done")
+}
+
+// ViewReadingLists godoc
+// @Summary Списки для чтения пользователя
+// @Tags Списки для чтения
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Токен авторизации"
+// @Success 200 {object} dto.GetReadingListsResponse
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/users/me/lists [get]
+func (c *ReadingListsController) ViewReadingLists(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewReadingLists"))
+
+	reqLogger.Info("View Reading Lists")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.GetReadingListsRequest
+	rows.UserId = user.UserId
+
+	response, err := c.srv.ViewReadingLists(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to view reading lists", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("ViewReadingLists done")
+}
+
+// ViewReadingList godoc
+// @Summary Список для чтения с постами
+// @Description Посты идут в порядке списка, снятые с публикации и удалённые посты пропускаются
+// @Tags Списки для чтения
+// @Accept json
+// @Produce json
+// @Param listId path string true "ID списка"
+// @Param Authorization header string true "Токен авторизации"
+// @Success 200 {object} dto.GetReadingListResponse
+// @Failure 404 {string} errors.ErrReadingListNotFound "reading list not found"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/users/me/lists/{listId} [get]
+func (c *ReadingListsController) ViewReadingList(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewReadingList"))
+
+	reqLogger.Info("View Reading List")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.GetReadingListRequest
+	rows.UserId = user.UserId
+	rows.ListId = r.PathValue("listId")
+
+	response, err := c.srv.ViewReadingList(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to view reading list", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrReadingListNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("ViewReadingList done")
+}
+
+// RenameReadingList godoc
+// @Summary Переименовать список для чтения
+// @Tags Списки для чтения
+// @Accept json
+// @Produce json
+// @Param listId path string true "ID списка"
+// @Param Authorization header string true "Токен авторизации"
+// @Param request body dto.RenameReadingListRequest true "Новое название списка"
+// @Success 200 {object} dto.RenameReadingListResponse
+// @Failure 400 {string} errors.ErrInvalidReadingListName "invalid reading list name"
+// @Failure 404 {string} errors.ErrReadingListNotFound "reading list not found"
+// @Failure 409 {string} errors.ErrReadingListAlreadyExists "reading list already exists"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/users/me/lists/{listId} [patch]
+func (c *ReadingListsController) RenameReadingList(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "RenameReadingList"))
+
+	reqLogger.Info("Rename Reading List")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.RenameReadingListRequest
+	err = json.NewDecoder(r.Body).Decode(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to decode request body", zap.Error(err))
+		http.Error(w, errors.ErrIncorrectData.Error(), http.StatusBadRequest)
+		return
+	}
+	rows.UserId = user.UserId
+	rows.ListId = r.PathValue("listId")
+
+	response, err := c.srv.RenameReadingList(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to rename reading list", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrReadingListNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case stderr.Is(err, errors.ErrInvalidReadingListName):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case stderr.Is(err, errors.ErrReadingListAlreadyExists):
+			http.Error(w, err.Error(), http.StatusConflict)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("RenameReadingList done")
+}
+
+// DeleteReadingList godoc
+// @Summary Удалить список для чтения
+// @Tags Списки для чтения
+// @Accept json
+// @Produce json
+// @Param listId path string true "ID списка"
+// @Param Authorization header string true "Токен авторизации"
+// @Success 200 {object} dto.DeleteReadingListResponse
+// @Failure 404 {string} errors.ErrReadingListNotFound "reading list not found"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/users/me/lists/{listId} [delete]
+func (c *ReadingListsController) DeleteReadingList(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "DeleteReadingList"))
+
+	reqLogger.Info("Delete Reading List")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.DeleteReadingListRequest
+	rows.UserId = user.UserId
+	rows.ListId = r.PathValue("listId")
+
+	response, err := c.srv.DeleteReadingList(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to delete reading list", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrReadingListNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("DeleteReadingList done")
+}
+
+// AddToReadingList godoc
+// @Summary Добавить пост в список для чтения или переместить его
+// @Description Без позиции или с позицией больше длины списка пост ставится в конец
+// @Tags Списки для чтения
+// @Accept json
+// @Produce json
+// @Param listId path string true "ID списка"
+// @Param postId path string true "ID поста"
+// @Param Authorization header string true "Токен авторизации"
+// @Param request body dto.AddToReadingListRequest false "Позиция поста в списке, начиная с 1"
+// @Success 200 {object} dto.AddToReadingListResponse
+// @Failure 400 {string} errors.ErrInvalidPosition "invalid position"
+// @Failure 404 {string} errors.ErrReadingListNotFound "reading list not found"
+// @Failure 404 {string} errors.ErrPostNotFound "post not found"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/users/me/lists/{listId}/posts/{postId} [put]
+func (c *ReadingListsController) AddToReadingList(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "AddToReadingList"))
+
+	reqLogger.Info("Add To Reading List")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.AddToReadingListRequest
+	err = json.NewDecoder(r.Body).Decode(&rows)
+	if err != nil && !stderr.Is(err, io.EOF) {
+		reqLogger.Error("Failed to decode request body", zap.Error(err))
+		http.Error(w, errors.ErrIncorrectData.Error(), http.StatusBadRequest)
+		return
+	}
+	rows.UserId = user.UserId
+	rows.ListId = r.PathValue("listId")
+	rows.PostId = r.PathValue("postId")
+
+	response, err := c.srv.AddToReadingList(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to add post to reading list", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrReadingListNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case stderr.Is(err, errors.ErrPostNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case stderr.Is(err, errors.ErrInvalidPosition):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("AddToReadingList done")
+}
+
+// RemoveFromReadingList godoc
+// @Summary Убрать пост из списка для чтения
+// @Tags Списки для чтения
+// @Accept json
+// @Produce json
+// @Param listId path string true "ID списка"
+// @Param postId path string true "ID поста"
+// @Param Authorization header string true "Токен авторизации"
+// @Success 200 {object} dto.RemoveFromReadingListResponse
+// @Failure 404 {string} errors.ErrReadingListNotFound "reading list not found"
+// @Failure 404 {string} errors.ErrPostNotFound "post not found"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/users/me/lists/{listId}/posts/{postId} [delete]
+func (c *ReadingListsController) RemoveFromReadingList(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "RemoveFromReadingList"))
+
+	reqLogger.Info("Remove From Reading List")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.RemoveFromReadingListRequest
+	rows.UserId = user.UserId
+	rows.ListId = r.PathValue("listId")
+	rows.PostId = r.PathValue("postId")
+
+	response, err := c.srv.RemoveFromReadingList(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to remove post from reading list", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrReadingListNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case stderr.Is(err, errors.ErrPostNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("RemoveFromReadingList done")
+}
@@ -0,0 +1,723 @@
+package controllers
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockReadingListsService struct {
+	mock.Mock
+}
+
+func (m *MockReadingListsService) CreateReadingList(rows *dto.CreateReadingListRequest) (*dto.CreateReadingListResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.CreateReadingListResponse), args.Error(1)
+}
+
+func (m *MockReadingListsService) ViewReadingLists(rows *dto.GetReadingListsRequest) (*dto.GetReadingListsResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetReadingListsResponse), args.Error(1)
+}
+
+func (m *MockReadingListsService) ViewReadingList(rows *dto.GetReadingListRequest) (*dto.GetReadingListResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetReadingListResponse), args.Error(1)
+}
+
+func (m *MockReadingListsService) RenameReadingList(rows *dto.RenameReadingListRequest) (*dto.RenameReadingListResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.RenameReadingListResponse), args.Error(1)
+}
+
+func (m *MockReadingListsService) DeleteReadingList(rows *dto.DeleteReadingListRequest) (*dto.DeleteReadingListResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.DeleteReadingListResponse), args.Error(1)
+}
+
+func (m *MockReadingListsService) AddToReadingList(rows *dto.AddToReadingListRequest) (*dto.AddToReadingListResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.AddToReadingListResponse), args.Error(1)
+}
+
+func (m *MockReadingListsService) RemoveFromReadingList(rows *dto.RemoveFromReadingListRequest) (*dto.RemoveFromReadingListResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.RemoveFromReadingListResponse), args.Error(1)
+}
+
+func TestReadingListsController_CreateReadingList(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockReadingListsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("CreateReadingList", mock.AnythingOfType("*dto.CreateReadingListRequest")).
+					Return(&dto.CreateReadingListResponse{
+						Message:     "message",
+						ReadingList: entities.ReadingList{ListId: "listId", Name: "Later"},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusCreated,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.CreateReadingListResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "Later", response.ReadingList.Name)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.ReaderRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "invalid name",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("CreateReadingList", mock.AnythingOfType("*dto.CreateReadingListRequest")).
+					Return(nil, errors.ErrInvalidReadingListName)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "already exists",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("CreateReadingList", mock.AnythingOfType("*dto.CreateReadingListRequest")).
+					Return(nil, errors.ErrReadingListAlreadyExists)
+			},
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("CreateReadingList", mock.AnythingOfType("*dto.CreateReadingListRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockReadingListsService := &MockReadingListsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockReadingListsService)
+			}
+
+			controller := NewReadingListsController(mockReadingListsService)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/users/me/lists", strings.NewReader(`{"name":"Later"}`))
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.CreateReadingList(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockReadingListsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReadingListsController_ViewReadingLists(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockReadingListsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("ViewReadingLists", mock.AnythingOfType("*dto.GetReadingListsRequest")).
+					Return(&dto.GetReadingListsResponse{
+						ReadingLists: []entities.ReadingList{{ListId: "listId", PostsCount: 2}},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetReadingListsResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, 2, response.ReadingLists[0].PostsCount)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.ReaderRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("ViewReadingLists", mock.AnythingOfType("*dto.GetReadingListsRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockReadingListsService := &MockReadingListsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockReadingListsService)
+			}
+
+			controller := NewReadingListsController(mockReadingListsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/users/me/lists", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewReadingLists(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockReadingListsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReadingListsController_ViewReadingList(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockReadingListsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("ViewReadingList", mock.AnythingOfType("*dto.GetReadingListRequest")).
+					Return(&dto.GetReadingListResponse{
+						ReadingList: entities.ReadingList{ListId: "listId", Posts: []entities.Post{{PostId: "postId"}}},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetReadingListResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "postId", response.ReadingList.Posts[0].PostId)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.ReaderRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "reading list not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("ViewReadingList", mock.AnythingOfType("*dto.GetReadingListRequest")).
+					Return(nil, errors.ErrReadingListNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("ViewReadingList", mock.AnythingOfType("*dto.GetReadingListRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockReadingListsService := &MockReadingListsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockReadingListsService)
+			}
+
+			controller := NewReadingListsController(mockReadingListsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/users/me/lists/listId", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewReadingList(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockReadingListsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReadingListsController_RenameReadingList(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockReadingListsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("RenameReadingList", mock.AnythingOfType("*dto.RenameReadingListRequest")).
+					Return(&dto.RenameReadingListResponse{
+						Message: "message",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.RenameReadingListResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "message", response.Message)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.ReaderRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "reading list not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("RenameReadingList", mock.AnythingOfType("*dto.RenameReadingListRequest")).
+					Return(nil, errors.ErrReadingListNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "invalid name",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("RenameReadingList", mock.AnythingOfType("*dto.RenameReadingListRequest")).
+					Return(nil, errors.ErrInvalidReadingListName)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "already exists",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("RenameReadingList", mock.AnythingOfType("*dto.RenameReadingListRequest")).
+					Return(nil, errors.ErrReadingListAlreadyExists)
+			},
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("RenameReadingList", mock.AnythingOfType("*dto.RenameReadingListRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockReadingListsService := &MockReadingListsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockReadingListsService)
+			}
+
+			controller := NewReadingListsController(mockReadingListsService)
+
+			req := httptest.NewRequest(http.MethodPatch, "/api/users/me/lists/listId", strings.NewReader(`{"name":"Later"}`))
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.RenameReadingList(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockReadingListsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReadingListsController_DeleteReadingList(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockReadingListsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("DeleteReadingList", mock.AnythingOfType("*dto.DeleteReadingListRequest")).
+					Return(&dto.DeleteReadingListResponse{
+						Message: "message",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.DeleteReadingListResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "message", response.Message)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.ReaderRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "reading list not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("DeleteReadingList", mock.AnythingOfType("*dto.DeleteReadingListRequest")).
+					Return(nil, errors.ErrReadingListNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("DeleteReadingList", mock.AnythingOfType("*dto.DeleteReadingListRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockReadingListsService := &MockReadingListsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockReadingListsService)
+			}
+
+			controller := NewReadingListsController(mockReadingListsService)
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/users/me/lists/listId", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.DeleteReadingList(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockReadingListsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReadingListsController_AddToReadingList(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockReadingListsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("AddToReadingList", mock.AnythingOfType("*dto.AddToReadingListRequest")).
+					Return(&dto.AddToReadingListResponse{
+						Message: "message",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.AddToReadingListResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "message", response.Message)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.ReaderRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "reading list not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("AddToReadingList", mock.AnythingOfType("*dto.AddToReadingListRequest")).
+					Return(nil, errors.ErrReadingListNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "post not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("AddToReadingList", mock.AnythingOfType("*dto.AddToReadingListRequest")).
+					Return(nil, errors.ErrPostNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "invalid position",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("AddToReadingList", mock.AnythingOfType("*dto.AddToReadingListRequest")).
+					Return(nil, errors.ErrInvalidPosition)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("AddToReadingList", mock.AnythingOfType("*dto.AddToReadingListRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockReadingListsService := &MockReadingListsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockReadingListsService)
+			}
+
+			controller := NewReadingListsController(mockReadingListsService)
+
+			req := httptest.NewRequest(http.MethodPut, "/api/users/me/lists/listId/posts/postId", strings.NewReader(`{"position":1}`))
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.AddToReadingList(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockReadingListsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReadingListsController_RemoveFromReadingList(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockReadingListsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("RemoveFromReadingList", mock.AnythingOfType("*dto.RemoveFromReadingListRequest")).
+					Return(&dto.RemoveFromReadingListResponse{
+						Message: "message",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.RemoveFromReadingListResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "message", response.Message)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.ReaderRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "reading list not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("RemoveFromReadingList", mock.AnythingOfType("*dto.RemoveFromReadingListRequest")).
+					Return(nil, errors.ErrReadingListNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "post not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("RemoveFromReadingList", mock.AnythingOfType("*dto.RemoveFromReadingListRequest")).
+					Return(nil, errors.ErrPostNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockReadingListsService) {
+				m.On("RemoveFromReadingList", mock.AnythingOfType("*dto.RemoveFromReadingListRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockReadingListsService := &MockReadingListsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockReadingListsService)
+			}
+
+			controller := NewReadingListsController(mockReadingListsService)
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/users/me/lists/listId/posts/postId", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.RemoveFromReadingList(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockReadingListsService.AssertExpectations(t)
+		})
+	}
+}
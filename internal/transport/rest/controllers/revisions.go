@@ -0,0 +1,268 @@
+package controllers
+
+import (
+	"blog/internal/logger"
+	"blog/internal/models/dto"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"encoding/json"
+	stderr "errors"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+// ViewRevisions godoc
+// @Summary История правок поста
+// @Tags Управление постами
+// @Accept json
+// @Produce json
+// @Param postId path string true "ID поста"
+// @Param Authorization header string true "Токен авторизации"
+// @Success 200 {object} dto.GetRevisionsResponse
+// @Failure 404 {string} errors.ErrPostNotFound "post not found"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/posts/{postId}/revisions [get]
+func (c *PostsController) ViewRevisions(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewRevisions"))
+
+	reqLogger.Info("View Revisions")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if user.Role != consts.AuthorRole {
+		reqLogger.Error("User have no permission", zap.Error(err))
+		http.Error(w, errors.ErrNoPermission.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.GetRevisionsRequest
+	rows.PostId = r.PathValue("postId")
+	rows.AuthorId = user.UserId
+
+	response, err := c.srv.ViewRevisions(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to view revisions", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrPostNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	reqLogger.Info("ViewRevisions done")
+}
+
+// ViewRevision godoc
+// @Summary Просмотр правки поста
+// @Tags Управление постами
+// @Accept json
+// @Produce json
+// @Param postId path string true "ID поста"
+// @Param revisionId path string true "ID правки"
+// @Param Authorization header string true "Токен авторизации"
+// @Success 200 {object} dto.GetRevisionResponse
+// @Failure 404 {string} errors.ErrRevisionNotFound "revision not found"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/posts/{postId}/revisions/{revisionId} [get]
+func (c *PostsController) ViewRevision(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewRevision"))
+
+	reqLogger.Info("View Revision")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if user.Role != consts.AuthorRole {
+		reqLogger.Error("User have no permission", zap.Error(err))
+		http.Error(w, errors.ErrNoPermission.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.GetRevisionRequest
+	rows.PostId = r.PathValue("postId")
+	rows.RevisionId = r.PathValue("revisionId")
+	rows.AuthorId = user.UserId
+
+	response, err := c.srv.ViewRevision(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to view revision", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrPostNotFound), stderr.Is(err, errors.ErrRevisionNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	reqLogger.Info("ViewRevision done")
+}
+
+// DiffRevisions godoc
+// @Summary Построчное сравнение двух правок поста
+// @Tags Управление постами
+// @Accept json
+// @Produce json
+// @Param postId path string true "ID поста"
+// @Param from query string true "ID исходной правки"
+// @Param to query string true "ID конечной правки"
+// @Param Authorization header string true "Токен авторизации"
+// @Success 200 {object} dto.DiffRevisionsResponse
+// @Failure 400 {string} errors.ErrInvalidQueryParams "invalid query params"
+// @Failure 404 {string} errors.ErrRevisionNotFound "revision not found"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/posts/{postId}/revisions/diff [get]
+func (c *PostsController) DiffRevisions(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "DiffRevisions"))
+
+	reqLogger.Info("Diff Revisions")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if user.Role != consts.AuthorRole {
+		reqLogger.Error("User have no permission", zap.Error(err))
+		http.Error(w, errors.ErrNoPermission.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.DiffRevisionsRequest
+	rows.PostId = r.PathValue("postId")
+	rows.From = r.URL.Query().Get("from")
+	rows.To = r.URL.Query().Get("to")
+	rows.AuthorId = user.UserId
+
+	response, err := c.srv.DiffRevisions(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to diff revisions", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrInvalidQueryParams):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case stderr.Is(err, errors.ErrPostNotFound), stderr.Is(err, errors.ErrRevisionNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	reqLogger.Info("DiffRevisions done")
+}
+
+// RestoreRevision godoc
+// @Summary Откатить пост к правке
+// @Tags Управление постами
+// @Accept json
+// @Produce json
+// @Param postId path string true "ID поста"
+// @Param revisionId path string true "ID правки"
+// @Param Authorization header string true "Токен авторизации"
+// @Param If-Match header string true "ETag текущей версии поста или *"
+// @Success 200 {object} dto.RestoreRevisionResponse
+// @Header 200 {string} ETag "Новая версия поста"
+// @Failure 404 {string} errors.ErrRevisionNotFound "revision not found"
+// @Failure 412 {string} errors.ErrPostVersionMismatch "post version mismatch"
+// @Failure 428 {string} errors.ErrPreconditionRequired "if-match header required"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/posts/{postId}/revisions/{revisionId}/restore [post]
+func (c *PostsController) RestoreRevision(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "RestoreRevision"))
+
+	reqLogger.Info("Restore Revision")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if user.Role != consts.AuthorRole {
+		reqLogger.Error("User have no permission", zap.Error(err))
+		http.Error(w, errors.ErrNoPermission.Error(), http.StatusForbidden)
+		return
+	}
+
+	version, err := parseIfMatch(r)
+	if err != nil {
+		reqLogger.Error("Failed to parse If-Match header", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrPreconditionRequired):
+			http.Error(w, err.Error(), http.StatusPreconditionRequired)
+		default:
+			http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		}
+		return
+	}
+
+	var rows dto.RestoreRevisionRequest
+	rows.PostId = r.PathValue("postId")
+	rows.RevisionId = r.PathValue("revisionId")
+	rows.AuthorId = user.UserId
+	rows.Version = version
+
+	response, err := c.srv.RestoreRevision(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to restore revision", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrPostNotFound), stderr.Is(err, errors.ErrRevisionNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case stderr.Is(err, errors.ErrPostVersionMismatch):
+			http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.Header().Set("ETag", postETag(response.Version))
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	reqLogger.Info("RestoreRevision done")
+}
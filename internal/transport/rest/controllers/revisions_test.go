@@ -0,0 +1,442 @@
+package controllers
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"blog/pkg/utils/diff"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPostsController_ViewRevisions(t *testing.T) {
+	postId := uuid.New().String()
+
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockPostsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewRevisions", mock.AnythingOfType("*dto.GetRevisionsRequest")).
+					Return(&dto.GetRevisionsResponse{
+						Revisions: []entities.Revision{{RevisionId: uuid.New().String(), PostId: postId}},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetRevisionsResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Len(t, response.Revisions, 1)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.AuthorRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "no permission",
+			role:               consts.ReaderRole,
+			key:                consts.CtxUserKey,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "post not found",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewRevisions", mock.AnythingOfType("*dto.GetRevisionsRequest")).
+					Return(nil, errors.ErrPostNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "not post author",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewRevisions", mock.AnythingOfType("*dto.GetRevisionsRequest")).
+					Return(nil, errors.ErrNoPermission)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockPostsService := &MockPostsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockPostsService)
+			}
+
+			controller := NewPostsController(mockPostsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/posts/"+postId+"/revisions", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewRevisions(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockPostsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPostsController_ViewRevision(t *testing.T) {
+	postId := uuid.New().String()
+	revisionId := uuid.New().String()
+
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockPostsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewRevision", mock.AnythingOfType("*dto.GetRevisionRequest")).
+					Return(&dto.GetRevisionResponse{
+						Revision: entities.Revision{RevisionId: revisionId, PostId: postId, Title: "title"},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetRevisionResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, revisionId, response.Revision.RevisionId)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.AuthorRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "no permission",
+			role:               consts.ReaderRole,
+			key:                consts.CtxUserKey,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "revision not found",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewRevision", mock.AnythingOfType("*dto.GetRevisionRequest")).
+					Return(nil, errors.ErrRevisionNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "not post author",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewRevision", mock.AnythingOfType("*dto.GetRevisionRequest")).
+					Return(nil, errors.ErrNoPermission)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockPostsService := &MockPostsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockPostsService)
+			}
+
+			controller := NewPostsController(mockPostsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/posts/"+postId+"/revisions/"+revisionId, nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewRevision(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockPostsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPostsController_DiffRevisions(t *testing.T) {
+	postId := uuid.New().String()
+	revisionId := uuid.New().String()
+
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockPostsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("DiffRevisions", mock.AnythingOfType("*dto.DiffRevisionsRequest")).
+					Return(&dto.DiffRevisionsResponse{
+						From:    revisionId,
+						To:      revisionId,
+						Content: []diff.Line{{Op: diff.Insert, Text: "line"}},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.DiffRevisionsResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, []diff.Line{{Op: diff.Insert, Text: "line"}}, response.Content)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.AuthorRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "no permission",
+			role:               consts.ReaderRole,
+			key:                consts.CtxUserKey,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "missing revisions",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("DiffRevisions", mock.AnythingOfType("*dto.DiffRevisionsRequest")).
+					Return(nil, errors.ErrInvalidQueryParams)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "revision not found",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("DiffRevisions", mock.AnythingOfType("*dto.DiffRevisionsRequest")).
+					Return(nil, errors.ErrRevisionNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockPostsService := &MockPostsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockPostsService)
+			}
+
+			controller := NewPostsController(mockPostsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/posts/"+postId+"/revisions/diff?from="+revisionId+"&to="+revisionId, nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.DiffRevisions(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockPostsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPostsController_RestoreRevision(t *testing.T) {
+	postId := uuid.New().String()
+	revisionId := uuid.New().String()
+
+	tests := []struct {
+		name               string
+		ifMatch            string
+		noIfMatch          bool
+		role               string
+		key                string
+		mockFunc           func(m *MockPostsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("RestoreRevision", mock.AnythingOfType("*dto.RestoreRevisionRequest")).
+					Return(&dto.RestoreRevisionResponse{
+						Message: "message",
+						Version: 2,
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.RestoreRevisionResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.NotEmpty(t, response.Message)
+				assert.Equal(t, 2, response.Version)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.AuthorRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "no permission",
+			role:               consts.ReaderRole,
+			key:                consts.CtxUserKey,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "revision not found",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("RestoreRevision", mock.AnythingOfType("*dto.RestoreRevisionRequest")).
+					Return(nil, errors.ErrRevisionNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "post not found",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("RestoreRevision", mock.AnythingOfType("*dto.RestoreRevisionRequest")).
+					Return(nil, errors.ErrPostNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "not post author",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("RestoreRevision", mock.AnythingOfType("*dto.RestoreRevisionRequest")).
+					Return(nil, errors.ErrNoPermission)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "missing if-match",
+			noIfMatch:          true,
+			role:               consts.AuthorRole,
+			key:                consts.CtxUserKey,
+			expectedStatusCode: http.StatusPreconditionRequired,
+		},
+		{
+			name:               "malformed if-match",
+			ifMatch:            "1",
+			role:               consts.AuthorRole,
+			key:                consts.CtxUserKey,
+			expectedStatusCode: http.StatusPreconditionFailed,
+		},
+		{
+			name:    "stale version",
+			ifMatch: `"1"`,
+			role:    consts.AuthorRole,
+			key:     consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("RestoreRevision", mock.MatchedBy(func(rows *dto.RestoreRevisionRequest) bool {
+					return rows.Version == 1
+				})).
+					Return(nil, errors.ErrPostVersionMismatch)
+			},
+			expectedStatusCode: http.StatusPreconditionFailed,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockPostsService := &MockPostsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockPostsService)
+			}
+
+			controller := NewPostsController(mockPostsService)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/posts/"+postId+"/revisions/"+revisionId+"/restore", nil)
+			if !test.noIfMatch {
+				ifMatch := test.ifMatch
+				if ifMatch == "" {
+					ifMatch = postETag(1)
+				}
+				req.Header.Set("If-Match", ifMatch)
+			}
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.RestoreRevision(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockPostsService.AssertExpectations(t)
+		})
+	}
+}
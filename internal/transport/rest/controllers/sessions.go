@@ -0,0 +1,120 @@
+package controllers
+
+import (
+	"blog/internal/logger"
+	"blog/internal/models/dto"
+	"blog/pkg/consts/errors"
+	"encoding/json"
+	stderr "errors"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+type SessionsService interface {
+	ViewSessions(rows *dto.GetSessionsRequest) (*dto.GetSessionsResponse, error)
+	RevokeSession(rows *dto.RevokeSessionRequest) (*dto.RevokeSessionResponse, error)
+}
+
+type SessionsController struct {
+	srv SessionsService
+}
+
+func NewSessionsController(srv SessionsService) *SessionsController {
+	return &SessionsController{
+		srv: srv,
+	}
+}
+
+// ViewSessions godoc
+// @Summary Активные сессии пользователя
+// @Description Сессии на всех устройствах, сначала недавно использованные. Сессия текущего токена отмечена полем current
+// @Tags Сессии
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Токен авторизации"
+// @Success 200 {object} dto.GetSessionsResponse
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/users/me/sessions [get]
+func (c *SessionsController) ViewSessions(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewSessions"))
+
+	reqLogger.Info("View Sessions")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.GetSessionsRequest
+	rows.UserId = user.UserId
+	rows.CurrentSessionId = user.SessionId
+
+	response, err := c.srv.ViewSessions(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to view sessions", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("ViewSessions done")
+}
+
+// RevokeSession godoc
+// @Summary Отозвать сессию
+// @Description Токены сессии перестают действовать, в том числе если это текущая сессия
+// @Tags Сессии
+// @Accept json
+// @Produce json
+// @Param sessionId path string true "ID сессии"
+// @Param Authorization header string true "Токен авторизации"
+// @Success 200 {object} dto.RevokeSessionResponse
+// @Failure 404 {string} errors.ErrSessionNotFound "session not found"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/users/me/sessions/{sessionId} [delete]
+func (c *SessionsController) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "RevokeSession"))
+
+	reqLogger.Info("Revoke Session")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.RevokeSessionRequest
+	rows.UserId = user.UserId
+	rows.SessionId = r.PathValue("sessionId")
+
+	response, err := c.srv.RevokeSession(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to revoke session", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrSessionNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("RevokeSession done")
+}
@@ -0,0 +1,198 @@
+package controllers
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSessionsService struct {
+	mock.Mock
+}
+
+func (m *MockSessionsService) ViewSessions(rows *dto.GetSessionsRequest) (*dto.GetSessionsResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetSessionsResponse), args.Error(1)
+}
+
+func (m *MockSessionsService) RevokeSession(rows *dto.RevokeSessionRequest) (*dto.RevokeSessionResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.RevokeSessionResponse), args.Error(1)
+}
+
+func TestSessionsController_ViewSessions(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockSessionsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockSessionsService) {
+				m.On("ViewSessions", mock.AnythingOfType("*dto.GetSessionsRequest")).
+					Return(&dto.GetSessionsResponse{
+						Sessions: []entities.Session{{SessionId: "sessionId", Device: "phone", Current: true}},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetSessionsResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "sessionId", response.Sessions[0].SessionId)
+				assert.Equal(t, "phone", response.Sessions[0].Device)
+				assert.True(t, response.Sessions[0].Current)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.ReaderRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockSessionsService) {
+				m.On("ViewSessions", mock.AnythingOfType("*dto.GetSessionsRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockSessionsService := &MockSessionsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockSessionsService)
+			}
+
+			controller := NewSessionsController(mockSessionsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/users/me/sessions", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewSessions(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockSessionsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSessionsController_RevokeSession(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockSessionsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockSessionsService) {
+				m.On("RevokeSession", mock.AnythingOfType("*dto.RevokeSessionRequest")).
+					Return(&dto.RevokeSessionResponse{
+						Message: "message",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.RevokeSessionResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "message", response.Message)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.ReaderRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "session not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockSessionsService) {
+				m.On("RevokeSession", mock.AnythingOfType("*dto.RevokeSessionRequest")).
+					Return(nil, errors.ErrSessionNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockSessionsService) {
+				m.On("RevokeSession", mock.AnythingOfType("*dto.RevokeSessionRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockSessionsService := &MockSessionsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockSessionsService)
+			}
+
+			controller := NewSessionsController(mockSessionsService)
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/users/me/sessions/sessionId", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.RevokeSession(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockSessionsService.AssertExpectations(t)
+		})
+	}
+}
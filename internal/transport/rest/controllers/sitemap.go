@@ -0,0 +1,112 @@
+package controllers
+
+import (
+	"blog/internal/logger"
+	"blog/internal/models/dto"
+	"blog/pkg/consts/errors"
+	stderr "errors"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+type SitemapService interface {
+	ViewSitemap() (*dto.GetSitemapResponse, error)
+	ViewSitemapFile(rows *dto.GetSitemapRequest) (*dto.GetSitemapResponse, error)
+	ViewRobots() *dto.GetRobotsResponse
+}
+
+type SitemapController struct {
+	srv SitemapService
+}
+
+func NewSitemapController(srv SitemapService) *SitemapController {
+	return &SitemapController{
+		srv: srv,
+	}
+}
+
+// ViewSitemap godoc
+// @Summary Карта сайта
+// @Description Опубликованные посты, страницы авторов и тегов. Если адресов больше 50 000, возвращается индекс карт сайта
+// @Tags SEO
+// @Produce xml
+// @Success 200 {string} string "sitemap или sitemap index"
+// @Router /sitemap.xml [get]
+func (c *SitemapController) ViewSitemap(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewSitemap"))
+
+	reqLogger.Info("View Sitemap")
+
+	response, err := c.srv.ViewSitemap()
+	if err != nil {
+		reqLogger.Error("Failed to view sitemap", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeSitemap(w, reqLogger, response)
+	reqLogger.Info("ViewSitemap done")
+}
+
+// ViewSitemapFile godoc
+// @Summary Часть карты сайта из индекса
+// @Tags SEO
+// @Produce xml
+// @Param file path string true "Имя файла (sitemap-N.xml)"
+// @Success 200 {string} string "sitemap"
+// @Failure 404 {string} errors.ErrSitemapNotFound "sitemap not found"
+// @Router /sitemaps/{file} [get]
+func (c *SitemapController) ViewSitemapFile(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewSitemapFile"))
+
+	reqLogger.Info("View Sitemap File")
+
+	var rows dto.GetSitemapRequest
+	rows.File = r.PathValue("file")
+
+	response, err := c.srv.ViewSitemapFile(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to view sitemap file", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrSitemapNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	writeSitemap(w, reqLogger, response)
+	reqLogger.Info("ViewSitemapFile done")
+}
+
+// ViewRobots godoc
+// @Summary robots.txt
+// @Tags SEO
+// @Produce plain
+// @Success 200 {string} string "robots.txt"
+// @Router /robots.txt [get]
+func (c *SitemapController) ViewRobots(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewRobots"))
+
+	reqLogger.Info("View Robots")
+
+	response := c.srv.ViewRobots()
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte(response.Body)); err != nil {
+		reqLogger.Error("Failed to write response", zap.Error(err))
+		return
+	}
+	reqLogger.Info("ViewRobots done")
+}
+
+func writeSitemap(w http.ResponseWriter, reqLogger logger.Logger, response *dto.GetSitemapResponse) {
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(response.Body); err != nil {
+		reqLogger.Error("Failed to write response", zap.Error(err))
+	}
+}
@@ -0,0 +1,155 @@
+package controllers
+
+import (
+	"blog/internal/models/dto"
+	"blog/pkg/consts/errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSitemapService struct {
+	mock.Mock
+}
+
+func (m *MockSitemapService) ViewSitemap() (*dto.GetSitemapResponse, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetSitemapResponse), args.Error(1)
+}
+
+func (m *MockSitemapService) ViewSitemapFile(rows *dto.GetSitemapRequest) (*dto.GetSitemapResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetSitemapResponse), args.Error(1)
+}
+
+func (m *MockSitemapService) ViewRobots() *dto.GetRobotsResponse {
+	args := m.Called()
+	return args.Get(0).(*dto.GetRobotsResponse)
+}
+
+func TestSitemapController_ViewSitemap(t *testing.T) {
+	tests := []struct {
+		name               string
+		mockFunc           func(m *MockSitemapService)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name: "successful",
+			mockFunc: func(m *MockSitemapService) {
+				m.On("ViewSitemap").
+					Return(&dto.GetSitemapResponse{Body: []byte("<urlset></urlset>")}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       "<urlset></urlset>",
+		},
+		{
+			name: "internal server error",
+			mockFunc: func(m *MockSitemapService) {
+				m.On("ViewSitemap").
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockSitemapService := &MockSitemapService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockSitemapService)
+			}
+
+			controller := NewSitemapController(mockSitemapService)
+
+			rr := httptest.NewRecorder()
+			controller.ViewSitemap(rr, httptest.NewRequest(http.MethodGet, "/sitemap.xml", nil))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.expectedBody != "" {
+				assert.Equal(t, test.expectedBody, rr.Body.String())
+				assert.Equal(t, "application/xml; charset=utf-8", rr.Header().Get("Content-Type"))
+			}
+
+			mockSitemapService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSitemapController_ViewSitemapFile(t *testing.T) {
+	tests := []struct {
+		name               string
+		mockFunc           func(m *MockSitemapService)
+		expectedStatusCode int
+	}{
+		{
+			name: "successful",
+			mockFunc: func(m *MockSitemapService) {
+				m.On("ViewSitemapFile", &dto.GetSitemapRequest{File: "sitemap-2.xml"}).
+					Return(&dto.GetSitemapResponse{Body: []byte("<urlset></urlset>")}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "sitemap not found",
+			mockFunc: func(m *MockSitemapService) {
+				m.On("ViewSitemapFile", mock.AnythingOfType("*dto.GetSitemapRequest")).
+					Return(nil, errors.ErrSitemapNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "internal server error",
+			mockFunc: func(m *MockSitemapService) {
+				m.On("ViewSitemapFile", mock.AnythingOfType("*dto.GetSitemapRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockSitemapService := &MockSitemapService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockSitemapService)
+			}
+
+			controller := NewSitemapController(mockSitemapService)
+
+			req := httptest.NewRequest(http.MethodGet, "/sitemaps/sitemap-2.xml", nil)
+			req.SetPathValue("file", "sitemap-2.xml")
+
+			rr := httptest.NewRecorder()
+			controller.ViewSitemapFile(rr, req)
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			mockSitemapService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSitemapController_ViewRobots(t *testing.T) {
+	mockSitemapService := &MockSitemapService{}
+	mockSitemapService.On("ViewRobots").
+		Return(&dto.GetRobotsResponse{Body: "User-agent: *\nDisallow:\n"})
+
+	controller := NewSitemapController(mockSitemapService)
+
+	rr := httptest.NewRecorder()
+	controller.ViewRobots(rr, httptest.NewRequest(http.MethodGet, "/robots.txt", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "text/plain; charset=utf-8", rr.Header().Get("Content-Type"))
+	assert.Equal(t, "User-agent: *\nDisallow:\n", rr.Body.String())
+	mockSitemapService.AssertExpectations(t)
+}
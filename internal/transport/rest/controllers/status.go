@@ -0,0 +1,69 @@
+package controllers
+
+import (
+	"blog/internal/logger"
+	"blog/internal/models/dto"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"encoding/json"
+	stderr "errors"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+// ViewStatusHistory godoc
+// @Summary История смены статусов поста
+// @Tags Управление постами
+// @Accept json
+// @Produce json
+// @Param postId path string true "ID поста"
+// @Param Authorization header string true "Токен авторизации"
+// @Success 200 {object} dto.GetStatusHistoryResponse
+// @Failure 404 {string} errors.ErrPostNotFound "post not found"
+// @Failure 403 {string} errors.ErrNoPermission "no permission"
+// @Router /api/posts/{postId}/status/history [get]
+func (c *PostsController) ViewStatusHistory(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewStatusHistory"))
+
+	reqLogger.Info("View Status History")
+
+	user, err := getUserFromCtx(r)
+	if err != nil {
+		reqLogger.Error("Failed to get user from context", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if user.Role != consts.AuthorRole {
+		reqLogger.Error("User have no permission", zap.Error(err))
+		http.Error(w, errors.ErrNoPermission.Error(), http.StatusForbidden)
+		return
+	}
+
+	var rows dto.GetStatusHistoryRequest
+	rows.PostId = r.PathValue("postId")
+	rows.AuthorId = user.UserId
+
+	response, err := c.srv.ViewStatusHistory(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to view status history", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrPostNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	reqLogger.Info("ViewStatusHistory done")
+}
@@ -0,0 +1,110 @@
+package controllers
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPostsController_ViewStatusHistory(t *testing.T) {
+	postId := uuid.New().String()
+
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockPostsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewStatusHistory", mock.AnythingOfType("*dto.GetStatusHistoryRequest")).
+					Return(&dto.GetStatusHistoryResponse{
+						Transitions: []entities.StatusTransition{{PostId: postId, FromStatus: consts.DraftState, ToStatus: consts.PublishedState}},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetStatusHistoryResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Len(t, response.Transitions, 1)
+			},
+		},
+		{
+			name:               "failed to get user",
+			role:               consts.AuthorRole,
+			key:                "testKey",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "no permission",
+			role:               consts.ReaderRole,
+			key:                consts.CtxUserKey,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "post not found",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewStatusHistory", mock.AnythingOfType("*dto.GetStatusHistoryRequest")).
+					Return(nil, errors.ErrPostNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "not post author",
+			role: consts.AuthorRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockPostsService) {
+				m.On("ViewStatusHistory", mock.AnythingOfType("*dto.GetStatusHistoryRequest")).
+					Return(nil, errors.ErrNoPermission)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockPostsService := &MockPostsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockPostsService)
+			}
+
+			controller := NewPostsController(mockPostsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/posts/"+postId+"/status/history", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewStatusHistory(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockPostsService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package controllers
+
+import (
+	"blog/internal/logger"
+	"blog/internal/models/dto"
+	"blog/pkg/consts/errors"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	stderr "errors"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type SyndicationService interface {
+	ViewFeed(rows *dto.GetSyndicationFeedRequest) (*dto.GetSyndicationFeedResponse, error)
+}
+
+type SyndicationController struct {
+	srv SyndicationService
+}
+
+func NewSyndicationController(srv SyndicationService) *SyndicationController {
+	return &SyndicationController{
+		srv: srv,
+	}
+}
+
+// ViewFeed godoc
+// @Summary Лента последних опубликованных постов блога
+// @Description Поддерживает условные запросы по If-None-Match
+// @Tags Ленты
+// @Produce xml
+// @Produce json
+// @Success 200 {string} string "RSS 2.0, Atom или JSON Feed"
+// @Success 304 {string} string "not modified"
+// @Router /api/feed.rss [get]
+// @Router /api/feed.atom [get]
+// @Router /api/feed.json [get]
+func (c *SyndicationController) ViewFeed(w http.ResponseWriter, r *http.Request) {
+	c.serveFeed(w, r, "ViewFeed", &dto.GetSyndicationFeedRequest{})
+}
+
+// ViewAuthorFeed godoc
+// @Summary Лента последних опубликованных постов автора
+// @Description Поддерживает условные запросы по If-None-Match
+// @Tags Ленты
+// @Produce xml
+// @Produce json
+// @Param authorId path string true "ID автора"
+// @Success 200 {string} string "RSS 2.0, Atom или JSON Feed"
+// @Success 304 {string} string "not modified"
+// @Failure 404 {string} errors.ErrAuthorNotFound "author not found"
+// @Router /api/authors/{authorId}/feed.rss [get]
+// @Router /api/authors/{authorId}/feed.atom [get]
+// @Router /api/authors/{authorId}/feed.json [get]
+func (c *SyndicationController) ViewAuthorFeed(w http.ResponseWriter, r *http.Request) {
+	c.serveFeed(w, r, "ViewAuthorFeed", &dto.GetSyndicationFeedRequest{AuthorId: r.PathValue("authorId")})
+}
+
+// ViewTagFeed godoc
+// @Summary Лента последних опубликованных постов с тегом
+// @Description Поддерживает условные запросы по If-None-Match
+// @Tags Ленты
+// @Produce xml
+// @Produce json
+// @Param slug path string true "Slug тега"
+// @Success 200 {string} string "RSS 2.0, Atom или JSON Feed"
+// @Success 304 {string} string "not modified"
+// @Failure 404 {string} errors.ErrTagNotFound "tag not found"
+// @Router /api/tags/{slug}/feed.rss [get]
+// @Router /api/tags/{slug}/feed.atom [get]
+// @Router /api/tags/{slug}/feed.json [get]
+func (c *SyndicationController) ViewTagFeed(w http.ResponseWriter, r *http.Request) {
+	c.serveFeed(w, r, "ViewTagFeed", &dto.GetSyndicationFeedRequest{Tag: r.PathValue("slug")})
+}
+
+// serveFeed takes the feed format from the extension of the requested path
+// and lets http.ServeContent answer conditional requests. Only the ETag is
+// sent: the newest post does not tell when a post was deleted or unpublished,
+// so a modification time could answer 304 to a changed feed.
+func (c *SyndicationController) serveFeed(w http.ResponseWriter, r *http.Request, name string, rows *dto.GetSyndicationFeedRequest) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", name))
+
+	reqLogger.Info("View Syndication Feed")
+
+	rows.Format = strings.TrimPrefix(path.Ext(r.URL.Path), ".")
+
+	response, err := c.srv.ViewFeed(rows)
+	if err != nil {
+		reqLogger.Error("Failed to view feed", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrAuthorNotFound), stderr.Is(err, errors.ErrTagNotFound), stderr.Is(err, errors.ErrInvalidFeedFormat):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, errors.ErrInternalServerError.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	hash := sha256.Sum256(response.Body)
+	w.Header().Set("Content-Type", response.ContentType)
+	w.Header().Set("ETag", `"`+hex.EncodeToString(hash[:16])+`"`)
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(response.Body))
+	reqLogger.Info(name + " done")
+}
@@ -0,0 +1,146 @@
+package controllers
+
+import (
+	"blog/internal/models/dto"
+	"blog/pkg/consts/errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSyndicationService struct {
+	mock.Mock
+}
+
+func (m *MockSyndicationService) ViewFeed(rows *dto.GetSyndicationFeedRequest) (*dto.GetSyndicationFeedResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetSyndicationFeedResponse), args.Error(1)
+}
+
+func TestSyndicationController_ViewAuthorFeed(t *testing.T) {
+	updatedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	feedResponse := &dto.GetSyndicationFeedResponse{
+		ContentType: "application/rss+xml; charset=utf-8",
+		Body:        []byte("<rss></rss>"),
+	}
+	staleETag := `"2d5b5ad1b5ae7196d4e2a2cf8a0a42b0"`
+
+	tests := []struct {
+		name               string
+		url                string
+		headers            map[string]string
+		mockFunc           func(m *MockSyndicationService)
+		expectedStatusCode int
+		checkResponse      func(t *testing.T, rr *httptest.ResponseRecorder)
+	}{
+		{
+			name: "successful",
+			url:  "/api/authors/authorId/feed.rss",
+			mockFunc: func(m *MockSyndicationService) {
+				m.On("ViewFeed", &dto.GetSyndicationFeedRequest{AuthorId: "authorId", Format: "rss"}).
+					Return(feedResponse, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponse: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				assert.Equal(t, "<rss></rss>", rr.Body.String())
+				assert.Equal(t, "application/rss+xml; charset=utf-8", rr.Header().Get("Content-Type"))
+				assert.Empty(t, rr.Header().Get("Last-Modified"))
+				assert.NotEmpty(t, rr.Header().Get("ETag"))
+			},
+		},
+		{
+			name:    "if-modified-since is ignored",
+			url:     "/api/authors/authorId/feed.rss",
+			headers: map[string]string{"If-Modified-Since": updatedAt.Format(http.TimeFormat)},
+			mockFunc: func(m *MockSyndicationService) {
+				m.On("ViewFeed", mock.AnythingOfType("*dto.GetSyndicationFeedRequest")).
+					Return(feedResponse, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponse: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				assert.Equal(t, "<rss></rss>", rr.Body.String())
+			},
+		},
+		{
+			name:    "stale etag",
+			url:     "/api/authors/authorId/feed.rss",
+			headers: map[string]string{"If-None-Match": staleETag},
+			mockFunc: func(m *MockSyndicationService) {
+				m.On("ViewFeed", mock.AnythingOfType("*dto.GetSyndicationFeedRequest")).
+					Return(feedResponse, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "author not found",
+			url:  "/api/authors/authorId/feed.atom",
+			mockFunc: func(m *MockSyndicationService) {
+				m.On("ViewFeed", &dto.GetSyndicationFeedRequest{AuthorId: "authorId", Format: "atom"}).
+					Return(nil, errors.ErrAuthorNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "internal server error",
+			url:  "/api/authors/authorId/feed.json",
+			mockFunc: func(m *MockSyndicationService) {
+				m.On("ViewFeed", mock.AnythingOfType("*dto.GetSyndicationFeedRequest")).
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockSyndicationService := &MockSyndicationService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockSyndicationService)
+			}
+
+			controller := NewSyndicationController(mockSyndicationService)
+
+			req := httptest.NewRequest(http.MethodGet, test.url, nil)
+			req.SetPathValue("authorId", "authorId")
+			for key, value := range test.headers {
+				req.Header.Set(key, value)
+			}
+
+			rr := httptest.NewRecorder()
+			controller.ViewAuthorFeed(rr, req)
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponse != nil {
+				test.checkResponse(t, rr)
+			}
+
+			mockSyndicationService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSyndicationController_ViewFeed_ETag(t *testing.T) {
+	mockSyndicationService := &MockSyndicationService{}
+	mockSyndicationService.On("ViewFeed", &dto.GetSyndicationFeedRequest{Format: "json"}).
+		Return(&dto.GetSyndicationFeedResponse{ContentType: "application/feed+json; charset=utf-8", Body: []byte("{}")}, nil)
+
+	controller := NewSyndicationController(mockSyndicationService)
+
+	rr := httptest.NewRecorder()
+	controller.ViewFeed(rr, httptest.NewRequest(http.MethodGet, "/api/feed.json", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	etag := rr.Header().Get("ETag")
+
+	req := httptest.NewRequest(http.MethodGet, "/api/feed.json", nil)
+	req.Header.Set("If-None-Match", etag)
+	rr = httptest.NewRecorder()
+	controller.ViewFeed(rr, req)
+	assert.Equal(t, http.StatusNotModified, rr.Code)
+}
@@ -0,0 +1,113 @@
+package controllers
+
+import (
+	"blog/internal/logger"
+	"blog/internal/models/dto"
+	"blog/pkg/consts/errors"
+	"encoding/json"
+	stderr "errors"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+type TagsService interface {
+	ViewTags() (*dto.GetTagsResponse, error)
+	ViewTagPosts(rows *dto.GetTagPostsRequest) (*dto.GetPostsResponse, error)
+}
+
+type TagsController struct {
+	srv TagsService
+}
+
+func NewTagsController(srv TagsService) *TagsController {
+	return &TagsController{
+		srv: srv,
+	}
+}
+
+// ViewTags godoc
+// @Summary Список тегов с количеством опубликованных постов
+// @Tags Теги
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.GetTagsResponse
+// @Router /api/tags [get]
+func (c *TagsController) ViewTags(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewTags"))
+
+	reqLogger.Info("View Tags")
+
+	response, err := c.srv.ViewTags()
+	if err != nil {
+		reqLogger.Error("Failed to view tags", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusForbidden)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("ViewTags done")
+}
+
+// ViewTagPosts godoc
+// @Summary Опубликованные посты с тегом
+// @Tags Теги
+// @Accept json
+// @Produce json
+// @Param slug path string true "Слаг тега"
+// @Param limit query int false "Количество постов на странице (1-100)"
+// @Param cursor query string false "Курсор следующей страницы"
+// @Param sort query string false "Поле сортировки (created_at, updated_at)"
+// @Param order query string false "Порядок сортировки (asc, desc)"
+// @Param author query string false "ID автора"
+// @Param from query string false "Начало периода создания (RFC 3339)"
+// @Param to query string false "Конец периода создания (RFC 3339)"
+// @Success 200 {object} dto.GetPostsResponse
+// @Failure 400 {string} errors.ErrInvalidQueryParams "invalid query params"
+// @Failure 404 {string} errors.ErrTagNotFound "tag not found"
+// @Router /api/tags/{slug}/posts [get]
+func (c *TagsController) ViewTagPosts(w http.ResponseWriter, r *http.Request) {
+	reqLogger := logger.LoggerFromContext(r.Context()).WithFields(zap.String("controller", "ViewTagPosts"))
+
+	reqLogger.Info("View Tag Posts")
+
+	query, err := parsePostsQuery(r)
+	if err != nil {
+		reqLogger.Error("Failed to parse query", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var rows dto.GetTagPostsRequest
+	rows.Slug = r.PathValue("slug")
+	rows.PostsQuery = query
+
+	response, err := c.srv.ViewTagPosts(&rows)
+	if err != nil {
+		reqLogger.Error("Failed to view tag posts", zap.Error(err))
+		switch {
+		case stderr.Is(err, errors.ErrTagNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case isBadQueryError(err):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, errors.ErrInternalServerError.Error(), http.StatusForbidden)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		reqLogger.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, errors.ErrInternalServerError.Error(), http.StatusInternalServerError)
+		return
+	}
+	reqLogger.Info("ViewTagPosts done")
+}
@@ -0,0 +1,204 @@
+package controllers
+
+import (
+	"blog/internal/models/dto"
+	"blog/internal/models/entities"
+	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTagsService struct {
+	mock.Mock
+}
+
+func (m *MockTagsService) ViewTags() (*dto.GetTagsResponse, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetTagsResponse), args.Error(1)
+}
+
+func (m *MockTagsService) ViewTagPosts(rows *dto.GetTagPostsRequest) (*dto.GetPostsResponse, error) {
+	args := m.Called(rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.GetPostsResponse), args.Error(1)
+}
+
+func TestTagsController_ViewTags(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockTagsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockTagsService) {
+				m.On("ViewTags").
+					Return(&dto.GetTagsResponse{
+						Tags: []entities.Tag{{Slug: "go", Name: "Go", PostsCount: 2}},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetTagsResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, 2, response.Tags[0].PostsCount)
+			},
+		},
+		{
+			name: "anonymous",
+			role: consts.ReaderRole,
+			key:  "testKey",
+			mockFunc: func(m *MockTagsService) {
+				m.On("ViewTags").
+					Return(&dto.GetTagsResponse{}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "internal server error",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockTagsService) {
+				m.On("ViewTags").
+					Return(nil, errors.ErrInternalServerError)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockTagsService := &MockTagsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockTagsService)
+			}
+
+			controller := NewTagsController(mockTagsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/tags", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewTags(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockTagsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTagsController_ViewTagPosts(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		key                string
+		mockFunc           func(m *MockTagsService)
+		expectedStatusCode int
+		checkResponseBody  func(t *testing.T, responseBody string)
+	}{
+		{
+			name: "successful",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockTagsService) {
+				m.On("ViewTagPosts", mock.AnythingOfType("*dto.GetTagPostsRequest")).
+					Return(&dto.GetPostsResponse{
+						Posts: make([]entities.Post, 1),
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponseBody: func(t *testing.T, responseBody string) {
+				var response dto.GetPostsResponse
+				err := json.Unmarshal([]byte(responseBody), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, len(response.Posts))
+			},
+		},
+		{
+			name: "anonymous",
+			role: consts.ReaderRole,
+			key:  "testKey",
+			mockFunc: func(m *MockTagsService) {
+				m.On("ViewTagPosts", mock.AnythingOfType("*dto.GetTagPostsRequest")).
+					Return(&dto.GetPostsResponse{}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "tag not found",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockTagsService) {
+				m.On("ViewTagPosts", mock.AnythingOfType("*dto.GetTagPostsRequest")).
+					Return(nil, errors.ErrTagNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "invalid cursor",
+			role: consts.ReaderRole,
+			key:  consts.CtxUserKey,
+			mockFunc: func(m *MockTagsService) {
+				m.On("ViewTagPosts", mock.AnythingOfType("*dto.GetTagPostsRequest")).
+					Return(nil, errors.ErrInvalidCursor)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockTagsService := &MockTagsService{}
+			if test.mockFunc != nil {
+				test.mockFunc(mockTagsService)
+			}
+
+			controller := NewTagsController(mockTagsService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/tags/go/posts?limit=10", nil)
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx := context.WithValue(req.Context(), test.key, &entities.User{
+				Role: test.role,
+			})
+
+			rr := httptest.NewRecorder()
+			controller.ViewTagPosts(rr, req.WithContext(ctx))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+
+			if test.checkResponseBody != nil {
+				test.checkResponseBody(t, rr.Body.String())
+			}
+
+			mockTagsService.AssertExpectations(t)
+		})
+	}
+}
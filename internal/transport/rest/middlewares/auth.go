@@ -3,6 +3,7 @@ package middlewares
 import (
 	"blog/internal/models/entities"
 	"blog/pkg/consts"
+	"blog/pkg/consts/errors"
 	"context"
 	"net/http"
 	"strings"
@@ -28,18 +29,29 @@ func (m *AuthMiddlewareHandler) AuthMiddleware(next http.Handler) http.Handler {
 			http.Error(w, "Unauthorized", http.StatusForbidden)
 			return
 		}
-		token := strings.Split(header, " ")
-		if len(token) != 2 {
+
+		user, err := m.authorize(header)
+		if err != nil {
 			http.Error(w, "Unauthorized", http.StatusForbidden)
 			return
 		}
 
-		if token[0] != "Bearer" {
-			http.Error(w, "Unauthorized", http.StatusForbidden)
+		ctx := context.WithValue(r.Context(), consts.CtxUserKey, user)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// OptionalAuthMiddleware lets anonymous requests through, but still rejects a
+// malformed or expired token instead of silently treating it as anonymous.
+func (m *AuthMiddlewareHandler) OptionalAuthMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		if header == "" {
+			next.ServeHTTP(w, r)
 			return
 		}
 
-		user, err := m.srv.AuthorizeUser(token[1])
+		user, err := m.authorize(header)
 		if err != nil {
 			http.Error(w, "Unauthorized", http.StatusForbidden)
 			return
@@ -49,3 +61,12 @@ func (m *AuthMiddlewareHandler) AuthMiddleware(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+func (m *AuthMiddlewareHandler) authorize(header string) (*entities.User, error) {
+	token := strings.Split(header, " ")
+	if len(token) != 2 || token[0] != "Bearer" {
+		return nil, errors.ErrInvalidAccessToken
+	}
+
+	return m.srv.AuthorizeUser(token[1])
+}
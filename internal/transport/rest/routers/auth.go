@@ -1,20 +1,30 @@
 package routers
 
 import (
+	"blog/internal/mailer"
 	"blog/internal/repository"
 	"blog/internal/service"
 	"blog/internal/transport/rest/controllers"
+	"blog/internal/transport/rest/middlewares"
+	"blog/internal/validation"
 	"net/http"
 )
 
-func NewAuthRouter(repo *repository.BlogRepository, secret string) (*http.ServeMux, *service.AuthService) {
-	srv := service.NewAuthService(repo, secret)
+func NewAuthRouter(repo *repository.BlogRepository, secret string, sender mailer.Sender, emailValidator validation.EmailValidator, mailCfg service.AuthMailConfig) (*http.ServeMux, *service.AuthService) {
+	srv := service.NewAuthService(repo, secret, sender, emailValidator, mailCfg)
 	controller := controllers.NewAuthController(srv)
+	auth := middlewares.NewAuthMiddlewareHandler(srv).AuthMiddleware
 	router := http.NewServeMux()
 
 	router.HandleFunc("POST /auth/register", controller.RegistrateUser)
 	router.HandleFunc("POST /auth/login", controller.LoginUser)
 	router.HandleFunc("POST /auth/refresh-token", controller.RefreshUserToken)
+	router.HandleFunc("POST /auth/logout", controller.LogoutUser)
+	router.HandleFunc("POST /auth/logout-all", controller.LogoutUserEverywhere)
+	router.HandleFunc("POST /auth/password/forgot", controller.ForgotPassword)
+	router.HandleFunc("POST /auth/password/reset", controller.ResetPassword)
+	router.HandleFunc("POST /auth/verify-email", controller.VerifyEmail)
+	router.Handle("POST /auth/verify-email/resend", auth(http.HandlerFunc(controller.ResendVerification)))
 
 	return router, srv
 }
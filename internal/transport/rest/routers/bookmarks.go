@@ -0,0 +1,28 @@
+package routers
+
+import (
+	"blog/internal/repository"
+	"blog/internal/service"
+	"blog/internal/transport/rest/controllers"
+	"net/http"
+)
+
+func NewBookmarksRouter(repo *repository.BlogRepository, auth Middleware) *http.ServeMux {
+	bookmarksController := controllers.NewBookmarksController(service.NewBookmarksService(repo))
+	readingListsController := controllers.NewReadingListsController(service.NewReadingListsService(repo))
+	router := http.NewServeMux()
+
+	router.Handle("GET /users/me/bookmarks", auth(http.HandlerFunc(bookmarksController.ViewBookmarks)))
+	router.Handle("PUT /users/me/bookmarks/{postId}", auth(http.HandlerFunc(bookmarksController.AddBookmark)))
+	router.Handle("DELETE /users/me/bookmarks/{postId}", auth(http.HandlerFunc(bookmarksController.DeleteBookmark)))
+
+	router.Handle("GET /users/me/lists", auth(http.HandlerFunc(readingListsController.ViewReadingLists)))
+	router.Handle("POST /users/me/lists", auth(http.HandlerFunc(readingListsController.CreateReadingList)))
+	router.Handle("GET /users/me/lists/{listId}", auth(http.HandlerFunc(readingListsController.ViewReadingList)))
+	router.Handle("PATCH /users/me/lists/{listId}", auth(http.HandlerFunc(readingListsController.RenameReadingList)))
+	router.Handle("DELETE /users/me/lists/{listId}", auth(http.HandlerFunc(readingListsController.DeleteReadingList)))
+	router.Handle("PUT /users/me/lists/{listId}/posts/{postId}", auth(http.HandlerFunc(readingListsController.AddToReadingList)))
+	router.Handle("DELETE /users/me/lists/{listId}/posts/{postId}", auth(http.HandlerFunc(readingListsController.RemoveFromReadingList)))
+
+	return router
+}
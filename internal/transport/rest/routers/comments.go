@@ -0,0 +1,26 @@
+package routers
+
+import (
+	"blog/internal/moderation"
+	"blog/internal/repository"
+	"blog/internal/service"
+	"blog/internal/transport/rest/controllers"
+	"net/http"
+)
+
+func NewCommentsRouter(repo *repository.BlogRepository, spamChecker moderation.SpamChecker, auth, optionalAuth Middleware) *http.ServeMux {
+	controller := controllers.NewCommentsController(service.NewCommentsService(repo, spamChecker))
+	moderationController := controllers.NewModerationController(service.NewModerationService(repo))
+	router := http.NewServeMux()
+
+	router.Handle("GET /posts/{postId}/comments", optionalAuth(http.HandlerFunc(controller.ViewComments)))
+	router.Handle("GET /posts/{postId}/comments/{commentId}/replies", optionalAuth(http.HandlerFunc(controller.ViewCommentReplies)))
+	router.Handle("POST /posts/{postId}/comments", auth(http.HandlerFunc(controller.CreateComment)))
+	router.Handle("PUT /posts/{postId}/comments/{commentId}", auth(http.HandlerFunc(controller.EditComment)))
+	router.Handle("DELETE /posts/{postId}/comments/{commentId}", auth(http.HandlerFunc(controller.DeleteComment)))
+	router.Handle("GET /moderation/comments", auth(http.HandlerFunc(moderationController.ViewModerationQueue)))
+	router.Handle("PATCH /moderation/comments/{commentId}", auth(http.HandlerFunc(moderationController.ModerateComment)))
+	router.Handle("POST /moderation/comments/approve", auth(http.HandlerFunc(moderationController.ApproveComments)))
+
+	return router
+}
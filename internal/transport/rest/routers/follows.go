@@ -0,0 +1,21 @@
+package routers
+
+import (
+	"blog/internal/repository"
+	"blog/internal/service"
+	"blog/internal/transport/rest/controllers"
+	"net/http"
+)
+
+func NewFollowsRouter(repo *repository.BlogRepository, auth Middleware) *http.ServeMux {
+	controller := controllers.NewFollowsController(service.NewFollowsService(repo))
+	router := http.NewServeMux()
+
+	router.Handle("GET /feed", auth(http.HandlerFunc(controller.ViewFeed)))
+	router.Handle("PUT /users/me/following/{authorId}", auth(http.HandlerFunc(controller.FollowAuthor)))
+	router.Handle("DELETE /users/me/following/{authorId}", auth(http.HandlerFunc(controller.UnfollowAuthor)))
+	router.HandleFunc("GET /authors/{authorId}/followers", controller.ViewFollowers)
+	router.HandleFunc("GET /authors/{authorId}/following", controller.ViewFollowing)
+
+	return router
+}
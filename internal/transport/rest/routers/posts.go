@@ -8,17 +8,24 @@ import (
 	"net/http"
 )
 
-func NewPostsRouter(repo *repository.BlogRepository, minio *minio.MinioClient) *http.ServeMux {
-	srv := service.NewPostsService(repo, minio, minio.Bucket)
+func NewPostsRouter(repo *repository.BlogRepository, minio *minio.MinioClient, language string, auth Middleware) *http.ServeMux {
+	srv := service.NewPostsService(repo, minio, minio.Bucket, language)
 	controller := controllers.NewPostsController(srv)
 	router := http.NewServeMux()
 
-	router.HandleFunc("POST /posts", controller.CreatePost)
-	router.HandleFunc("POST /posts/{postId}/images", controller.AddImageToPost)
-	router.HandleFunc("PUT /posts/{postId}", controller.EditPost)
-	router.HandleFunc("DELETE /posts/{postId}/images/{imageId}", controller.DeleteImageFromPost)
-	router.HandleFunc("PATCH /posts/{postId}/status", controller.PublishPost)
-	router.HandleFunc("GET /posts", controller.ViewPosts)
+	router.Handle("POST /posts", auth(http.HandlerFunc(controller.CreatePost)))
+	router.Handle("POST /posts/{postId}/images", auth(http.HandlerFunc(controller.AddImageToPost)))
+	router.Handle("PUT /posts/{postId}", auth(http.HandlerFunc(controller.EditPost)))
+	router.Handle("DELETE /posts/{postId}", auth(http.HandlerFunc(controller.DeletePost)))
+	router.Handle("POST /posts/{postId}/restore", auth(http.HandlerFunc(controller.RestorePost)))
+	router.Handle("GET /posts/trash", auth(http.HandlerFunc(controller.ViewTrash)))
+	router.Handle("DELETE /posts/{postId}/images/{imageId}", auth(http.HandlerFunc(controller.DeleteImageFromPost)))
+	router.Handle("PATCH /posts/{postId}/status", auth(http.HandlerFunc(controller.PublishPost)))
+	router.Handle("GET /posts/{postId}/status/history", auth(http.HandlerFunc(controller.ViewStatusHistory)))
+	router.Handle("GET /posts/{postId}/revisions", auth(http.HandlerFunc(controller.ViewRevisions)))
+	router.Handle("GET /posts/{postId}/revisions/diff", auth(http.HandlerFunc(controller.DiffRevisions)))
+	router.Handle("GET /posts/{postId}/revisions/{revisionId}", auth(http.HandlerFunc(controller.ViewRevision)))
+	router.Handle("POST /posts/{postId}/revisions/{revisionId}/restore", auth(http.HandlerFunc(controller.RestoreRevision)))
 
 	return router
 }
@@ -0,0 +1,26 @@
+package routers
+
+import (
+	"blog/internal/repository"
+	"blog/internal/service"
+	"blog/internal/storage/minio"
+	"blog/internal/transport/rest/controllers"
+	"net/http"
+)
+
+func NewPublicRouter(repo *repository.BlogRepository, minio *minio.MinioClient, language string, optionalAuth Middleware) *http.ServeMux {
+	postsController := controllers.NewPostsController(service.NewPostsService(repo, minio, minio.Bucket, language))
+	tagsController := controllers.NewTagsController(service.NewTagsService(repo))
+	authorsController := controllers.NewAuthorsController(service.NewAuthorsService(repo))
+	router := http.NewServeMux()
+
+	router.Handle("GET /posts", optionalAuth(http.HandlerFunc(postsController.ViewPosts)))
+	router.HandleFunc("GET /posts/search", postsController.SearchPosts)
+	router.Handle("GET /posts/{postIdOrSlug}", optionalAuth(http.HandlerFunc(postsController.ViewPost)))
+	router.HandleFunc("GET /tags", tagsController.ViewTags)
+	router.HandleFunc("GET /tags/{slug}/posts", tagsController.ViewTagPosts)
+	router.HandleFunc("GET /authors/{authorId}", authorsController.ViewAuthor)
+	router.HandleFunc("GET /authors/{authorId}/posts", authorsController.ViewAuthorPosts)
+
+	return router
+}
@@ -0,0 +1,18 @@
+package routers
+
+import (
+	"blog/internal/repository"
+	"blog/internal/service"
+	"blog/internal/transport/rest/controllers"
+	"net/http"
+)
+
+func NewReactionsRouter(repo *repository.BlogRepository, auth Middleware) *http.ServeMux {
+	controller := controllers.NewReactionsController(service.NewReactionsService(repo))
+	router := http.NewServeMux()
+
+	router.Handle("POST /posts/{postId}/reactions/{reaction}", auth(http.HandlerFunc(controller.ToggleReaction)))
+	router.Handle("GET /users/me/reactions", auth(http.HandlerFunc(controller.ViewReactedPosts)))
+
+	return router
+}
@@ -0,0 +1,19 @@
+package routers
+
+import "net/http"
+
+type Middleware func(http.Handler) http.Handler
+
+// Chain serves a request with the first router that has a route for it, so
+// routers with different auth requirements can share path prefixes.
+func Chain(routers ...*http.ServeMux) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, router := range routers {
+			if _, pattern := router.Handler(r); pattern != "" {
+				router.ServeHTTP(w, r)
+				return
+			}
+		}
+		http.NotFound(w, r)
+	})
+}
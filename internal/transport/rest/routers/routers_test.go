@@ -0,0 +1,81 @@
+package routers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChain(t *testing.T) {
+	private := http.NewServeMux()
+	private.HandleFunc("POST /posts", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("private"))
+	})
+	private.HandleFunc("GET /posts/trash", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("private"))
+	})
+
+	public := http.NewServeMux()
+	public.HandleFunc("GET /posts", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("public"))
+	})
+	public.HandleFunc("GET /posts/{postIdOrSlug}", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("public " + r.PathValue("postIdOrSlug")))
+	})
+
+	tests := []struct {
+		name               string
+		method             string
+		url                string
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:               "first router",
+			method:             http.MethodPost,
+			url:                "/posts",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       "private",
+		},
+		{
+			name:               "first router wins on overlap",
+			method:             http.MethodGet,
+			url:                "/posts/trash",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       "private",
+		},
+		{
+			name:               "falls through on method mismatch",
+			method:             http.MethodGet,
+			url:                "/posts",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       "public",
+		},
+		{
+			name:               "path values",
+			method:             http.MethodGet,
+			url:                "/posts/hello-world",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       "public hello-world",
+		},
+		{
+			name:               "not found",
+			method:             http.MethodGet,
+			url:                "/unknown",
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			Chain(private, public).ServeHTTP(rr, httptest.NewRequest(test.method, test.url, nil))
+
+			assert.Equal(t, test.expectedStatusCode, rr.Code)
+			if test.expectedBody != "" {
+				assert.Equal(t, test.expectedBody, rr.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package routers
+
+import (
+	"blog/internal/repository"
+	"blog/internal/service"
+	"blog/internal/transport/rest/controllers"
+	"net/http"
+)
+
+func NewSessionsRouter(repo *repository.BlogRepository, auth Middleware) *http.ServeMux {
+	controller := controllers.NewSessionsController(service.NewSessionsService(repo))
+	router := http.NewServeMux()
+
+	router.Handle("GET /users/me/sessions", auth(http.HandlerFunc(controller.ViewSessions)))
+	router.Handle("DELETE /users/me/sessions/{sessionId}", auth(http.HandlerFunc(controller.RevokeSession)))
+
+	return router
+}
@@ -0,0 +1,19 @@
+package routers
+
+import (
+	"blog/internal/repository"
+	"blog/internal/service"
+	"blog/internal/transport/rest/controllers"
+	"net/http"
+)
+
+func NewSitemapRouter(repo *repository.BlogRepository, baseURL string, robotsDisallow []string) *http.ServeMux {
+	controller := controllers.NewSitemapController(service.NewSitemapService(repo, baseURL, robotsDisallow))
+	router := http.NewServeMux()
+
+	router.HandleFunc("GET /sitemap.xml", controller.ViewSitemap)
+	router.HandleFunc("GET /sitemaps/{file}", controller.ViewSitemapFile)
+	router.HandleFunc("GET /robots.txt", controller.ViewRobots)
+
+	return router
+}
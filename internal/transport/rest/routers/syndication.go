@@ -0,0 +1,22 @@
+package routers
+
+import (
+	"blog/internal/repository"
+	"blog/internal/service"
+	"blog/internal/transport/rest/controllers"
+	"blog/pkg/consts"
+	"net/http"
+)
+
+func NewSyndicationRouter(repo *repository.BlogRepository, baseURL, title string) *http.ServeMux {
+	controller := controllers.NewSyndicationController(service.NewSyndicationService(repo, baseURL, title))
+	router := http.NewServeMux()
+
+	for _, format := range []string{consts.RSSFeedFormat, consts.AtomFeedFormat, consts.JSONFeedFormat} {
+		router.HandleFunc("GET /feed."+format, controller.ViewFeed)
+		router.HandleFunc("GET /authors/{authorId}/feed."+format, controller.ViewAuthorFeed)
+		router.HandleFunc("GET /tags/{slug}/feed."+format, controller.ViewTagFeed)
+	}
+
+	return router
+}
@@ -5,18 +5,37 @@ import (
 	_ "blog/docs"
 	"blog/internal/database/postgre"
 	"blog/internal/logger"
+	"blog/internal/mailer"
+	"blog/internal/moderation"
 	"blog/internal/repository"
+	"blog/internal/service"
 	"blog/internal/storage/minio"
 	"blog/internal/transport/rest/middlewares"
 	"blog/internal/transport/rest/routers"
+	"blog/internal/validation"
 	"fmt"
 	"log"
 	"net/http"
+	"time"
 )
 
 type BlogServerConfig struct {
-	Port   string `env:"PORT" env-default:"8080"`
-	Secret string `env:"SECRET" env-default:"secret"`
+	Port     string `env:"PORT" env-default:"8080"`
+	Secret   string `env:"SECRET" env-default:"secret"`
+	Language string `env:"POSTS_LANGUAGE" env-default:"simple"`
+
+	PublicBaseURL string `env:"PUBLIC_BASE_URL" env-default:"http://localhost:8080"`
+	Title         string `env:"BLOG_TITLE" env-default:"Blog"`
+
+	RobotsDisallow []string `env:"ROBOTS_DISALLOW" env-separator:"," env-default:"/auth/,/api/auth/,/api/users/,/api/moderation/,/swagger/"`
+
+	PasswordResetURL      string        `env:"PASSWORD_RESET_URL" env-default:"http://localhost:8080/reset-password"`
+	PasswordResetTTL      time.Duration `env:"PASSWORD_RESET_TTL" env-default:"1h"`
+	PasswordResetInterval time.Duration `env:"PASSWORD_RESET_INTERVAL" env-default:"1m"`
+
+	EmailVerificationURL            string        `env:"EMAIL_VERIFICATION_URL" env-default:"http://localhost:8080/verify-email"`
+	EmailVerificationTTL            time.Duration `env:"EMAIL_VERIFICATION_TTL" env-default:"24h"`
+	EmailVerificationResendInterval time.Duration `env:"EMAIL_VERIFICATION_RESEND_INTERVAL" env-default:"1m"`
 }
 
 type BlogServer struct {
@@ -24,7 +43,7 @@ type BlogServer struct {
 	server *http.Server
 }
 
-func NewBlogServer(cfg BlogServerConfig, minioClient *minio.MinioClient, db *postgre.DB, zapLogger logger.Logger) (*BlogServer, error) {
+func NewBlogServer(cfg BlogServerConfig, minioClient *minio.MinioClient, db *postgre.DB, spamChecker moderation.SpamChecker, sender mailer.Sender, emailValidator validation.EmailValidator, zapLogger logger.Logger) (*BlogServer, error) {
 	mainRouter := http.NewServeMux()
 
 	swagger := api.NewSwagger()
@@ -32,16 +51,35 @@ func NewBlogServer(cfg BlogServerConfig, minioClient *minio.MinioClient, db *pos
 
 	repo := repository.NewBlogRepository(db.DB)
 
-	authRouter, authService := routers.NewAuthRouter(repo, cfg.Secret)
-	postsRouter := routers.NewPostsRouter(repo, minioClient)
+	authRouter, authService := routers.NewAuthRouter(repo, cfg.Secret, sender, emailValidator, service.AuthMailConfig{
+		PasswordResetURL:           cfg.PasswordResetURL,
+		PasswordResetTTL:           cfg.PasswordResetTTL,
+		PasswordResetInterval:      cfg.PasswordResetInterval,
+		VerificationURL:            cfg.EmailVerificationURL,
+		VerificationTTL:            cfg.EmailVerificationTTL,
+		VerificationResendInterval: cfg.EmailVerificationResendInterval,
+	})
+
+	authMiddlewareHandler := middlewares.NewAuthMiddlewareHandler(authService)
+	authMiddleware := authMiddlewareHandler.AuthMiddleware
+	optionalAuthMiddleware := authMiddlewareHandler.OptionalAuthMiddleware
+
+	postsRouter := routers.NewPostsRouter(repo, minioClient, cfg.Language, authMiddleware)
+	reactionsRouter := routers.NewReactionsRouter(repo, authMiddleware)
+	bookmarksRouter := routers.NewBookmarksRouter(repo, authMiddleware)
+	followsRouter := routers.NewFollowsRouter(repo, authMiddleware)
+	sessionsRouter := routers.NewSessionsRouter(repo, authMiddleware)
+	syndicationRouter := routers.NewSyndicationRouter(repo, cfg.PublicBaseURL, cfg.Title)
+	sitemapRouter := routers.NewSitemapRouter(repo, cfg.PublicBaseURL, cfg.RobotsDisallow)
+	commentsRouter := routers.NewCommentsRouter(repo, spamChecker, authMiddleware, optionalAuthMiddleware)
+	publicRouter := routers.NewPublicRouter(repo, minioClient, cfg.Language, optionalAuthMiddleware)
 
-	authMiddleware := middlewares.NewAuthMiddlewareHandler(authService).AuthMiddleware
 	globalMiddleware := middlewares.GlobalMiddleware
 
 	loggerMiddleware := middlewares.LoggerMiddleware(zapLogger)
 
 	mainRouter.Handle("/auth/", authRouter)
-	mainRouter.Handle("/", authMiddleware(postsRouter)) //т.к. /posts не совместим с /posts/{id}
+	mainRouter.Handle("/", routers.Chain(postsRouter, commentsRouter, reactionsRouter, bookmarksRouter, followsRouter, sessionsRouter, syndicationRouter, sitemapRouter, publicRouter))
 
 	mainRouter.Handle("/api/", http.StripPrefix("/api", loggerMiddleware(globalMiddleware(mainRouter))))
 	mainRouter.Handle("/swagger/", swagger.Router)
@@ -0,0 +1,219 @@
+package validation
+
+import (
+	"blog/pkg/consts/errors"
+	"blog/pkg/utils/mail"
+	"bufio"
+	"context"
+	stderr "errors"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+type EmailValidator interface {
+	Validate(email string) error
+}
+
+type EmailValidatorConfig struct {
+	CheckMX               bool          `env:"EMAIL_CHECK_MX" env-default:"false"`
+	MXTimeout             time.Duration `env:"EMAIL_MX_TIMEOUT" env-default:"2s"`
+	MXCacheTTL            time.Duration `env:"EMAIL_MX_CACHE_TTL" env-default:"1h"`
+	DisposableDomainsFile string        `env:"EMAIL_DISPOSABLE_DOMAINS_FILE"`
+}
+
+// NewEmailValidator always checks the syntax and adds the disposable-domain
+// blocklist and the MX lookup when they are configured.
+func NewEmailValidator(cfg EmailValidatorConfig) (EmailValidator, error) {
+	validators := ChainValidator{SyntaxValidator{}}
+
+	if cfg.DisposableDomainsFile != "" {
+		validator, err := NewDisposableValidatorFromFile(cfg.DisposableDomainsFile)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, validator)
+	}
+
+	if cfg.CheckMX {
+		validators = append(validators, NewMXValidator(net.DefaultResolver, cfg.MXTimeout, cfg.MXCacheTTL))
+	}
+
+	return validators, nil
+}
+
+// ChainValidator runs the validators in order and stops at the first error.
+type ChainValidator []EmailValidator
+
+func (c ChainValidator) Validate(email string) error {
+	for _, validator := range c {
+		if err := validator.Validate(email); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type SyntaxValidator struct{}
+
+func (SyntaxValidator) Validate(email string) error {
+	if !mail.IsValidEmail(email) {
+		return errors.ErrInvalidEmail
+	}
+	return nil
+}
+
+// DisposableValidator rejects addresses at blocklisted domains and their
+// subdomains.
+type DisposableValidator struct {
+	domains map[string]struct{}
+}
+
+func NewDisposableValidator(domains []string) *DisposableValidator {
+	validator := &DisposableValidator{
+		domains: make(map[string]struct{}, len(domains)),
+	}
+	for _, domain := range domains {
+		if domain = strings.ToLower(strings.TrimSpace(domain)); domain != "" {
+			validator.domains[domain] = struct{}{}
+		}
+	}
+	return validator
+}
+
+// NewDisposableValidatorFromFile reads the blocklist with one domain per
+// line; empty lines and lines starting with # are skipped.
+func NewDisposableValidatorFromFile(path string) (*DisposableValidator, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var domains []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		domains = append(domains, line)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return NewDisposableValidator(domains), nil
+}
+
+func (v *DisposableValidator) Validate(email string) error {
+	domain := mail.Domain(email)
+	for {
+		if _, ok := v.domains[domain]; ok {
+			return errors.ErrDisposableEmail
+		}
+		dot := strings.IndexByte(domain, '.')
+		if dot < 0 {
+			return nil
+		}
+		domain = domain[dot+1:]
+	}
+}
+
+type MXResolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+}
+
+// maxMXCacheEntries bounds the MX cache so that registrations with many
+// distinct domains cannot grow it without limit.
+const maxMXCacheEntries = 10000
+
+type mxCacheEntry struct {
+	ok        bool
+	expiresAt time.Time
+}
+
+// MXValidator rejects domains without MX records. Lookups that time out or
+// fail for other reasons than a missing domain let the address through, so
+// registration keeps working while DNS is unavailable.
+type MXValidator struct {
+	resolver MXResolver
+	timeout  time.Duration
+	ttl      time.Duration
+
+	mu         sync.Mutex
+	cache      map[string]mxCacheEntry
+	maxEntries int
+	now        func() time.Time
+}
+
+func NewMXValidator(resolver MXResolver, timeout, ttl time.Duration) *MXValidator {
+	return &MXValidator{
+		resolver:   resolver,
+		timeout:    timeout,
+		ttl:        ttl,
+		cache:      make(map[string]mxCacheEntry),
+		maxEntries: maxMXCacheEntries,
+		now:        time.Now,
+	}
+}
+
+func (v *MXValidator) Validate(email string) error {
+	domain := mail.Domain(email)
+
+	v.mu.Lock()
+	entry, ok := v.cache[domain]
+	v.mu.Unlock()
+	if !ok || !v.now().Before(entry.expiresAt) {
+		var cacheable bool
+		entry.ok, cacheable = v.lookup(domain)
+		if cacheable {
+			entry.expiresAt = v.now().Add(v.ttl)
+			v.store(domain, entry)
+		}
+	}
+
+	if !entry.ok {
+		return errors.ErrInvalidEmail
+	}
+	return nil
+}
+
+// store caches the entry. When the cache is full, expired entries are evicted
+// first and, if that does not free any space, the whole cache is dropped.
+func (v *MXValidator) store(domain string, entry mxCacheEntry) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if _, ok := v.cache[domain]; !ok && len(v.cache) >= v.maxEntries {
+		now := v.now()
+		for cached, cachedEntry := range v.cache {
+			if !now.Before(cachedEntry.expiresAt) {
+				delete(v.cache, cached)
+			}
+		}
+		if len(v.cache) >= v.maxEntries {
+			clear(v.cache)
+		}
+	}
+
+	v.cache[domain] = entry
+}
+
+func (v *MXValidator) lookup(domain string) (ok bool, cacheable bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), v.timeout)
+	defer cancel()
+
+	records, err := v.resolver.LookupMX(ctx, domain)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if stderr.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return false, true
+		}
+		return true, false
+	}
+
+	return len(records) > 0, true
+}
@@ -0,0 +1,135 @@
+package validation
+
+import (
+	"blog/pkg/consts/errors"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeResolver struct {
+	records []*net.MX
+	err     error
+	calls   int
+}
+
+func (r *fakeResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	r.calls++
+	return r.records, r.err
+}
+
+func TestDisposableValidator_Validate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "disposable.txt")
+	err := os.WriteFile(path, []byte("# blocklist\nmailinator.com\n\n  Tempmail.Dev  \n"), 0o600)
+	assert.NoError(t, err)
+
+	validator, err := NewDisposableValidatorFromFile(path)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		email       string
+		expectedErr error
+	}{
+		{name: "allowed", email: "test@yandex.ru"},
+		{name: "blocked", email: "test@mailinator.com", expectedErr: errors.ErrDisposableEmail},
+		{name: "blocked case-insensitive", email: "test@MAILINATOR.com", expectedErr: errors.ErrDisposableEmail},
+		{name: "blocked subdomain", email: "test@eu.tempmail.dev", expectedErr: errors.ErrDisposableEmail},
+		{name: "similar domain", email: "test@notmailinator.com"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectedErr, validator.Validate(test.email))
+		})
+	}
+}
+
+func TestMXValidator_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		records       []*net.MX
+		err           error
+		expectedErr   error
+		expectedCalls int
+	}{
+		{
+			name:          "has mx",
+			records:       []*net.MX{{Host: "mx.yandex.ru.", Pref: 10}},
+			expectedCalls: 1,
+		},
+		{
+			name:          "no mx",
+			expectedErr:   errors.ErrInvalidEmail,
+			expectedCalls: 1,
+		},
+		{
+			name:          "domain not found",
+			err:           &net.DNSError{Err: "no such host", IsNotFound: true},
+			expectedErr:   errors.ErrInvalidEmail,
+			expectedCalls: 1,
+		},
+		{
+			name:          "timeout is not cached",
+			err:           &net.DNSError{Err: "i/o timeout", IsTimeout: true},
+			expectedCalls: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resolver := &fakeResolver{records: test.records, err: test.err}
+			validator := NewMXValidator(resolver, time.Second, time.Hour)
+
+			assert.Equal(t, test.expectedErr, validator.Validate("test@yandex.ru"))
+			assert.Equal(t, test.expectedErr, validator.Validate("other@Yandex.ru"))
+			assert.Equal(t, test.expectedCalls, resolver.calls)
+		})
+	}
+}
+
+func TestMXValidator_CacheExpires(t *testing.T) {
+	resolver := &fakeResolver{records: []*net.MX{{Host: "mx.yandex.ru.", Pref: 10}}}
+	validator := NewMXValidator(resolver, time.Second, time.Hour)
+	now := time.Now()
+	validator.now = func() time.Time { return now }
+
+	assert.NoError(t, validator.Validate("test@yandex.ru"))
+	now = now.Add(time.Hour)
+	assert.NoError(t, validator.Validate("test@yandex.ru"))
+	assert.Equal(t, 2, resolver.calls)
+}
+
+func TestMXValidator_CacheIsBounded(t *testing.T) {
+	resolver := &fakeResolver{records: []*net.MX{{Host: "mx.yandex.ru.", Pref: 10}}}
+	validator := NewMXValidator(resolver, time.Second, time.Hour)
+	validator.maxEntries = 2
+	now := time.Now()
+	validator.now = func() time.Time { return now }
+
+	assert.NoError(t, validator.Validate("test@a.ru"))
+	now = now.Add(time.Hour)
+	assert.NoError(t, validator.Validate("test@b.ru"))
+	assert.NoError(t, validator.Validate("test@c.ru"))
+	assert.Len(t, validator.cache, 2)
+	assert.NotContains(t, validator.cache, "a.ru")
+
+	assert.NoError(t, validator.Validate("test@d.ru"))
+	assert.LessOrEqual(t, len(validator.cache), 2)
+	assert.Contains(t, validator.cache, "d.ru")
+}
+
+func TestNewEmailValidator(t *testing.T) {
+	validator, err := NewEmailValidator(EmailValidatorConfig{})
+	assert.NoError(t, err)
+	assert.NoError(t, validator.Validate("test@yandex.ru"))
+	assert.Equal(t, errors.ErrInvalidEmail, validator.Validate("test@localhost"))
+
+	_, err = NewEmailValidator(EmailValidatorConfig{DisposableDomainsFile: filepath.Join(t.TempDir(), "missing.txt")})
+	assert.Error(t, err)
+}
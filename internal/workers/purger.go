@@ -0,0 +1,73 @@
+package workers
+
+import (
+	"blog/internal/logger"
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type TrashPurgerConfig struct {
+	Retention time.Duration `env:"TRASH_RETENTION" env-default:"720h"`
+	Interval  time.Duration `env:"TRASH_PURGE_INTERVAL" env-default:"1h"`
+}
+
+type TrashPurgerService interface {
+	PurgeTrash(before time.Time) (int, error)
+}
+
+type SessionsPurgerService interface {
+	PurgeSessions(now time.Time) (int, error)
+}
+
+// TrashPurger periodically deletes trashed posts past their retention and
+// sessions that can no longer be used.
+type TrashPurger struct {
+	cfg         TrashPurgerConfig
+	srv         TrashPurgerService
+	sessionsSrv SessionsPurgerService
+	logger      logger.Logger
+}
+
+func NewTrashPurger(cfg TrashPurgerConfig, srv TrashPurgerService, sessionsSrv SessionsPurgerService, zapLogger logger.Logger) *TrashPurger {
+	return &TrashPurger{
+		cfg:         cfg,
+		srv:         srv,
+		sessionsSrv: sessionsSrv,
+		logger:      zapLogger.WithFields(zap.String("worker", "TrashPurger")),
+	}
+}
+
+func (p *TrashPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		p.purge()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *TrashPurger) purge() {
+	now := time.Now()
+
+	purged, err := p.srv.PurgeTrash(now.Add(-p.cfg.Retention))
+	if err != nil {
+		p.logger.Error("Failed to purge trash", zap.Int("purged", purged), zap.Error(err))
+	} else if purged > 0 {
+		p.logger.Info("Trash purged", zap.Int("purged", purged))
+	}
+
+	purged, err = p.sessionsSrv.PurgeSessions(now)
+	if err != nil {
+		p.logger.Error("Failed to purge sessions", zap.Error(err))
+	} else if purged > 0 {
+		p.logger.Info("Sessions purged", zap.Int("purged", purged))
+	}
+}
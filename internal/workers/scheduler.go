@@ -0,0 +1,57 @@
+package workers
+
+import (
+	"blog/internal/logger"
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type PostSchedulerConfig struct {
+	Interval time.Duration `env:"POST_SCHEDULER_INTERVAL" env-default:"30s"`
+}
+
+type PostSchedulerService interface {
+	PublishScheduledPosts(now time.Time) (int, error)
+}
+
+type PostScheduler struct {
+	cfg    PostSchedulerConfig
+	srv    PostSchedulerService
+	logger logger.Logger
+}
+
+func NewPostScheduler(cfg PostSchedulerConfig, srv PostSchedulerService, zapLogger logger.Logger) *PostScheduler {
+	return &PostScheduler{
+		cfg:    cfg,
+		srv:    srv,
+		logger: zapLogger.WithFields(zap.String("worker", "PostScheduler")),
+	}
+}
+
+func (s *PostScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.publish()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *PostScheduler) publish() {
+	published, err := s.srv.PublishScheduledPosts(time.Now())
+	if err != nil {
+		s.logger.Error("Failed to publish scheduled posts", zap.Int("published", published), zap.Error(err))
+		return
+	}
+	if published > 0 {
+		s.logger.Info("Scheduled posts published", zap.Int("published", published))
+	}
+}
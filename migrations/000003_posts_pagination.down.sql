@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_posts_status_updated_at;
+DROP INDEX IF EXISTS idx_posts_status_created_at;
+DROP INDEX IF EXISTS idx_posts_author_updated_at;
+DROP INDEX IF EXISTS idx_posts_author_created_at;
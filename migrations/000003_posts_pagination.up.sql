@@ -0,0 +1,4 @@
+CREATE INDEX IF NOT EXISTS idx_posts_author_created_at ON posts (author_id, created_at, post_id);
+CREATE INDEX IF NOT EXISTS idx_posts_author_updated_at ON posts (author_id, updated_at, post_id);
+CREATE INDEX IF NOT EXISTS idx_posts_status_created_at ON posts (status, created_at, post_id);
+CREATE INDEX IF NOT EXISTS idx_posts_status_updated_at ON posts (status, updated_at, post_id);
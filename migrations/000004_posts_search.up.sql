@@ -0,0 +1,8 @@
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS language REGCONFIG NOT NULL DEFAULT 'simple';
+
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector(language, coalesce(title, '')), 'A') ||
+    setweight(to_tsvector(language, coalesce(content, '')), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_posts_search_vector ON posts USING GIN (search_vector);
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS tags (
+    tag_id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    slug VARCHAR(64) UNIQUE NOT NULL,
+    name VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS post_tags (
+    post_id UUID NOT NULL,
+    tag_id UUID NOT NULL,
+    PRIMARY KEY (post_id, tag_id),
+    CONSTRAINT fk_post_tags_posts
+                                  FOREIGN KEY (post_id)
+                                  REFERENCES posts(post_id)
+                                  ON DELETE CASCADE,
+    CONSTRAINT fk_post_tags_tags
+                                  FOREIGN KEY (tag_id)
+                                  REFERENCES tags(tag_id)
+                                  ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_tags_tag_id ON post_tags (tag_id);
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS post_revisions (
+    revision_id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    post_id UUID NOT NULL,
+    author_id UUID NOT NULL,
+    title TEXT NOT NULL,
+    content TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_post_revisions_posts
+                                  FOREIGN KEY (post_id)
+                                  REFERENCES posts(post_id)
+                                  ON DELETE CASCADE,
+    CONSTRAINT fk_post_revisions_users
+                                  FOREIGN KEY (author_id)
+                                  REFERENCES users(user_id)
+                                  ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_revisions_post_id_created_at ON post_revisions (post_id, created_at DESC);
+
+INSERT INTO post_revisions (post_id, author_id, title, content, created_at)
+SELECT post_id, author_id, title, content, updated_at FROM posts;
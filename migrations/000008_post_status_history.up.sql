@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS post_status_history (
+    transition_id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    post_id UUID NOT NULL,
+    changed_by UUID NOT NULL,
+    from_status VARCHAR(10) NOT NULL,
+    to_status VARCHAR(10) NOT NULL,
+    changed_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_post_status_history_posts
+                                  FOREIGN KEY (post_id)
+                                  REFERENCES posts(post_id)
+                                  ON DELETE CASCADE,
+    CONSTRAINT fk_post_status_history_users
+                                  FOREIGN KEY (changed_by)
+                                  REFERENCES users(user_id)
+                                  ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_status_history_post_id_changed_at ON post_status_history (post_id, changed_at DESC);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_posts_scheduled_publish_at;
+
+UPDATE posts SET status = 'Draft' WHERE status = 'Scheduled';
+
+ALTER TABLE posts DROP COLUMN IF EXISTS publish_at;
@@ -0,0 +1,3 @@
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS publish_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_posts_scheduled_publish_at ON posts (publish_at) WHERE status = 'Scheduled' AND deleted_at IS NULL;
@@ -0,0 +1,19 @@
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS slug TEXT;
+
+UPDATE posts SET slug = COALESCE(NULLIF(left(trim(BOTH '-' FROM lower(regexp_replace(title, '[^a-zA-Z0-9]+', '-', 'g'))), 55), '') || '-', '') || left(post_id::text, 8)
+WHERE slug IS NULL;
+
+ALTER TABLE posts ALTER COLUMN slug SET NOT NULL;
+ALTER TABLE posts ADD CONSTRAINT posts_slug_key UNIQUE (slug);
+
+CREATE TABLE IF NOT EXISTS post_slug_redirects (
+    slug TEXT PRIMARY KEY,
+    post_id UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_post_slug_redirects_posts
+                                  FOREIGN KEY (post_id)
+                                  REFERENCES posts(post_id)
+                                  ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_slug_redirects_post_id ON post_slug_redirects (post_id);
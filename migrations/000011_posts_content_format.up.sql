@@ -0,0 +1,7 @@
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS content_format VARCHAR(16) NOT NULL DEFAULT 'plain';
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS content_html TEXT NOT NULL DEFAULT '';
+
+UPDATE posts SET content_html = '<p>' || replace(replace(replace(replace(replace(replace(content, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '''', '&#39;'), '"', '&#34;'), E'\n', E'<br>\n') || '</p>'
+WHERE content <> '';
+
+ALTER TABLE post_revisions ADD COLUMN IF NOT EXISTS content_format VARCHAR(16) NOT NULL DEFAULT 'plain';
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS comments (
+    comment_id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    post_id UUID NOT NULL,
+    author_id UUID NOT NULL,
+    parent_id UUID,
+    content TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_comments_posts
+                                  FOREIGN KEY (post_id)
+                                  REFERENCES posts(post_id)
+                                  ON DELETE CASCADE,
+    CONSTRAINT fk_comments_users
+                                  FOREIGN KEY (author_id)
+                                  REFERENCES users(user_id)
+                                  ON DELETE CASCADE,
+    CONSTRAINT fk_comments_parent
+                                  FOREIGN KEY (parent_id)
+                                  REFERENCES comments(comment_id)
+                                  ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_comments_post_id_created_at ON comments (post_id, created_at, comment_id) WHERE parent_id IS NULL;
+CREATE INDEX IF NOT EXISTS idx_comments_parent_id ON comments (parent_id);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_comments_author_id_created_at;
+DROP INDEX IF EXISTS idx_comments_status_created_at;
+
+DELETE FROM comments WHERE status <> 'Approved';
+
+ALTER TABLE comments DROP COLUMN IF EXISTS spam_reason;
+ALTER TABLE comments DROP COLUMN IF EXISTS status;
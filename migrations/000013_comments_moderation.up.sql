@@ -0,0 +1,6 @@
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS status VARCHAR(10) NOT NULL DEFAULT 'Approved';
+ALTER TABLE comments ALTER COLUMN status SET DEFAULT 'Pending';
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS spam_reason TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_comments_status_created_at ON comments (status, created_at, comment_id);
+CREATE INDEX IF NOT EXISTS idx_comments_author_id_created_at ON comments (author_id, created_at);
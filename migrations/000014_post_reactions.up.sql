@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS post_reactions (
+    post_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    reaction VARCHAR(16) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT post_reactions_pkey PRIMARY KEY (post_id, user_id, reaction),
+    CONSTRAINT fk_post_reactions_posts
+                                  FOREIGN KEY (post_id)
+                                  REFERENCES posts(post_id)
+                                  ON DELETE CASCADE,
+    CONSTRAINT fk_post_reactions_users
+                                  FOREIGN KEY (user_id)
+                                  REFERENCES users(user_id)
+                                  ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_reactions_user_id_created_at ON post_reactions (user_id, created_at DESC);
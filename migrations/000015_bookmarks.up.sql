@@ -0,0 +1,47 @@
+CREATE TABLE IF NOT EXISTS bookmarks (
+    user_id UUID NOT NULL,
+    post_id UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT bookmarks_pkey PRIMARY KEY (user_id, post_id),
+    CONSTRAINT fk_bookmarks_users
+                                  FOREIGN KEY (user_id)
+                                  REFERENCES users(user_id)
+                                  ON DELETE CASCADE,
+    CONSTRAINT fk_bookmarks_posts
+                                  FOREIGN KEY (post_id)
+                                  REFERENCES posts(post_id)
+                                  ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_bookmarks_user_id_created_at ON bookmarks (user_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS reading_lists (
+    list_id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT reading_lists_user_id_name_key UNIQUE (user_id, name),
+    CONSTRAINT fk_reading_lists_users
+                                  FOREIGN KEY (user_id)
+                                  REFERENCES users(user_id)
+                                  ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS reading_list_posts (
+    list_id UUID NOT NULL,
+    post_id UUID NOT NULL,
+    position INTEGER NOT NULL,
+    added_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT reading_list_posts_pkey PRIMARY KEY (list_id, post_id),
+    CONSTRAINT fk_reading_list_posts_reading_lists
+                                  FOREIGN KEY (list_id)
+                                  REFERENCES reading_lists(list_id)
+                                  ON DELETE CASCADE,
+    CONSTRAINT fk_reading_list_posts_posts
+                                  FOREIGN KEY (post_id)
+                                  REFERENCES posts(post_id)
+                                  ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_reading_list_posts_list_id_position ON reading_list_posts (list_id, position);
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS follows (
+    follower_id UUID NOT NULL,
+    author_id UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT follows_pkey PRIMARY KEY (follower_id, author_id),
+    CONSTRAINT follows_not_self CHECK (follower_id <> author_id),
+    CONSTRAINT fk_follows_followers
+                                  FOREIGN KEY (follower_id)
+                                  REFERENCES users(user_id)
+                                  ON DELETE CASCADE,
+    CONSTRAINT fk_follows_authors
+                                  FOREIGN KEY (author_id)
+                                  REFERENCES users(user_id)
+                                  ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_follows_author_id_created_at ON follows (author_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_follows_follower_id_created_at ON follows (follower_id, created_at DESC);
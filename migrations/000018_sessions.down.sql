@@ -0,0 +1,5 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS refresh_token TEXT;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS refresh_token_expiry_time TIMESTAMP WITH TIME ZONE;
+
+DROP TABLE IF EXISTS session_tokens;
+DROP TABLE IF EXISTS sessions;
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS sessions (
+    session_id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    device VARCHAR(100) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    ip VARCHAR(45) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_used_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT fk_sessions_users
+                                  FOREIGN KEY (user_id)
+                                  REFERENCES users(user_id)
+                                  ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions (user_id) WHERE revoked_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS session_tokens (
+    token_hash CHAR(64) PRIMARY KEY,
+    session_id UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    rotated_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT fk_session_tokens_sessions
+                                  FOREIGN KEY (session_id)
+                                  REFERENCES sessions(session_id)
+                                  ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_session_tokens_session_id ON session_tokens (session_id);
+
+ALTER TABLE users DROP COLUMN IF EXISTS refresh_token;
+ALTER TABLE users DROP COLUMN IF EXISTS refresh_token_expiry_time;
@@ -0,0 +1,7 @@
+ALTER TABLE comments DROP CONSTRAINT IF EXISTS fk_comments_parent;
+ALTER TABLE comments ADD CONSTRAINT fk_comments_parent
+                                  FOREIGN KEY (parent_id)
+                                  REFERENCES comments(comment_id)
+                                  ON DELETE CASCADE;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS deleted_at;
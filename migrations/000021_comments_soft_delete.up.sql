@@ -0,0 +1,6 @@
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE comments DROP CONSTRAINT IF EXISTS fk_comments_parent;
+ALTER TABLE comments ADD CONSTRAINT fk_comments_parent
+                                  FOREIGN KEY (parent_id)
+                                  REFERENCES comments(comment_id);
@@ -8,4 +8,75 @@ const (
 
 	DraftState     string = "Draft"
 	PublishedState string = "Published"
+	ArchivedState  string = "Archived"
+	ScheduledState string = "Scheduled"
+
+	PendingCommentState  string = "Pending"
+	ApprovedCommentState string = "Approved"
+	RejectedCommentState string = "Rejected"
+
+	LikeReaction  string = "like"
+	HeartReaction string = "heart"
+	LaughReaction string = "laugh"
+	WowReaction   string = "wow"
+	SadReaction   string = "sad"
+	FireReaction  string = "fire"
+
+	SimpleLanguage  string = "simple"
+	EnglishLanguage string = "english"
+	RussianLanguage string = "russian"
+
+	PlainContentFormat    string = "plain"
+	MarkdownContentFormat string = "markdown"
+
+	RSSFeedFormat  string = "rss"
+	AtomFeedFormat string = "atom"
+	JSONFeedFormat string = "json"
+
+	PostSitemapEntry   string = "post"
+	AuthorSitemapEntry string = "author"
+	TagSitemapEntry    string = "tag"
+
+	SMTPMailDriver string = "smtp"
+	LogMailDriver  string = "log"
+
+	SortByCreatedAt string = "created_at"
+	SortByUpdatedAt string = "updated_at"
+
+	OrderAsc  string = "asc"
+	OrderDesc string = "desc"
+
+	AnyPostVersion int = -1
+
+	DefaultPostsLimit int = 20
+	MaxPostsLimit     int = 100
+
+	MaxSearchQueryLength int = 256
+
+	MaxPostTags      int = 10
+	MaxTagNameLength int = 64
+
+	ScheduledPostsBatchSize int = 100
+
+	DefaultCommentsLimit int = 20
+	MaxCommentsLimit     int = 100
+	MaxCommentLength     int = 10000
+	MaxModeratedComments int = 100
+	MaxThreadReplies     int = 50
+
+	DeletedCommentContent string = "[deleted]"
+
+	DefaultReactedPostsLimit int = 20
+	MaxReactedPostsLimit     int = 100
+
+	DefaultBookmarksLimit    int = 20
+	MaxBookmarksLimit        int = 100
+	MaxReadingListNameLength int = 100
+
+	DefaultFollowsLimit int = 20
+	MaxFollowsLimit     int = 100
+
+	SyndicationFeedSize int = 20
+
+	MaxDeviceLength int = 100
 )
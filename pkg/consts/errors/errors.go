@@ -14,6 +14,7 @@ var (
 
 	ErrUserAlreadyExists = errors.New("user already exists")
 	ErrInvalidEmail      = errors.New("invalid email")
+	ErrDisposableEmail   = errors.New("disposable email addresses are not allowed")
 	ErrInvalidRole       = errors.New("invalid role")
 
 	ErrInvalidEmailOrPassword = errors.New("invalid email or password")
@@ -25,13 +26,24 @@ var (
 	ErrNoPermission  = errors.New("no permission")
 	ErrIncorrectData = errors.New("incorrect data")
 
+	ErrInvalidQueryParams = errors.New("invalid query params")
+	ErrInvalidCursor      = errors.New("invalid cursor")
+
 	ErrPostNotFound        = errors.New("post not found")
 	ErrPostOrImageNotFound = errors.New("post or image not found")
 
-	ErrInvalidPostId     = errors.New("invalid post id")
-	ErrInvalidPostStatus = errors.New("invalid post status")
-	ErrInvalidUser       = errors.New("invalid user")
-	ErrInvalidUserId     = errors.New("invalid user id")
+	ErrSlugAlreadyExists    = errors.New("slug already exists")
+	ErrPostVersionMismatch  = errors.New("post version mismatch")
+	ErrPreconditionRequired = errors.New("if-match header required")
+
+	ErrInvalidPostId           = errors.New("invalid post id")
+	ErrInvalidPostStatus       = errors.New("invalid post status")
+	ErrInvalidStatusTransition = errors.New("invalid post status transition")
+	ErrInvalidPublishAt        = errors.New("invalid publish_at")
+	ErrInvalidLanguage         = errors.New("invalid language")
+	ErrInvalidContentFormat    = errors.New("invalid content format")
+	ErrInvalidUser             = errors.New("invalid user")
+	ErrInvalidUserId           = errors.New("invalid user id")
 
 	ErrMinioBucketNotExists    = errors.New("minio bucket does not exist")
 	ErrMinioMakeBucket         = errors.New("minio cant make bucket")
@@ -39,6 +51,49 @@ var (
 	ErrMinioPresignedGetObject = errors.New("minio cant presigned get object")
 	ErrMinioGetObject          = errors.New("minio cant get object")
 	ErrMinioRemoveObject       = errors.New("minio cant remove object")
+	ErrMinioListObjects        = errors.New("minio cant list objects")
 
 	ErrInvalidImageId = errors.New("invalid image id")
+
+	ErrAuthorNotFound = errors.New("author not found")
+
+	ErrTagNotFound = errors.New("tag not found")
+	ErrInvalidTags = errors.New("invalid tags")
+
+	ErrRevisionNotFound = errors.New("revision not found")
+
+	ErrCommentNotFound      = errors.New("comment not found")
+	ErrInvalidComment       = errors.New("invalid comment")
+	ErrInvalidParentComment = errors.New("invalid parent comment")
+	ErrInvalidCommentStatus = errors.New("invalid comment status")
+	ErrInvalidCommentIds    = errors.New("invalid comment ids")
+
+	ErrInvalidReaction = errors.New("invalid reaction")
+
+	ErrBookmarkNotFound         = errors.New("bookmark not found")
+	ErrReadingListNotFound      = errors.New("reading list not found")
+	ErrReadingListAlreadyExists = errors.New("reading list already exists")
+	ErrInvalidReadingListName   = errors.New("invalid reading list name")
+	ErrInvalidPosition          = errors.New("invalid position")
+
+	ErrFollowNotFound       = errors.New("follow not found")
+	ErrCannotFollowYourself = errors.New("cannot follow yourself")
+
+	ErrInvalidFeedFormat = errors.New("invalid feed format")
+
+	ErrSitemapNotFound = errors.New("sitemap not found")
+
+	ErrSessionNotFound = errors.New("session not found")
+
+	ErrInvalidResetToken = errors.New("invalid password reset token")
+	ErrInvalidPassword   = errors.New("invalid password")
+
+	ErrInvalidVerificationToken = errors.New("invalid email verification token")
+	ErrEmailAlreadyVerified     = errors.New("email already verified")
+	ErrEmailNotVerified         = errors.New("email not verified")
+	ErrTooManyRequests          = errors.New("too many requests")
+
+	ErrUnknownMailDriver = errors.New("unknown mail driver")
+	ErrInvalidMailHeader = errors.New("invalid mail header")
+	ErrMailQueueFull     = errors.New("mail queue is full")
 )
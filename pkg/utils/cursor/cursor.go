@@ -0,0 +1,46 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type payload struct {
+	Sort  string    `json:"s"`
+	Value time.Time `json:"v"`
+	Id    string    `json:"id"`
+}
+
+func Encode(sort string, value time.Time, id string) string {
+	data, _ := json.Marshal(payload{
+		Sort:  sort,
+		Value: value,
+		Id:    id,
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func Decode(cursor, sort string) (time.Time, string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+
+	var p payload
+	if err = json.Unmarshal(data, &p); err != nil {
+		return time.Time{}, "", err
+	}
+
+	if p.Sort != sort {
+		return time.Time{}, "", fmt.Errorf("cursor does not match sort %q", sort)
+	}
+	if _, err = uuid.Parse(p.Id); err != nil {
+		return time.Time{}, "", err
+	}
+
+	return p.Value, p.Id, nil
+}
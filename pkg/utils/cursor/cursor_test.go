@@ -0,0 +1,37 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	value := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	id := uuid.NewString()
+
+	decodedValue, decodedId, err := Decode(Encode("comments", value, id), "comments")
+	assert.NoError(t, err)
+	assert.True(t, value.Equal(decodedValue))
+	assert.Equal(t, id, decodedId)
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "other sort", cursor: Encode("replies", value, id)},
+		{name: "empty id", cursor: Encode("comments", value, "")},
+		{name: "id is not a uuid", cursor: Encode("comments", value, "1' OR '1'='1")},
+		{name: "not base64", cursor: "!!!"},
+		{name: "not json", cursor: base64.RawURLEncoding.EncodeToString([]byte("cursor"))},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := Decode(test.cursor, "comments")
+			assert.Error(t, err)
+		})
+	}
+}
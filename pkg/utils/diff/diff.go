@@ -0,0 +1,145 @@
+package diff
+
+import "strings"
+
+const (
+	Equal  = "equal"
+	Insert = "insert"
+	Delete = "delete"
+)
+
+// MaxEdits bounds the edit distance the diff searches for. The trace kept for
+// backtracking grows quadratically with the number of edits, so past the
+// bound the changed part is reported as deleted and inserted as a whole.
+const MaxEdits = 1000
+
+type Line struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+func Lines(a, b string) []Line {
+	linesA, linesB := splitLines(a), splitLines(b)
+
+	prefix := 0
+	for prefix < len(linesA) && prefix < len(linesB) && linesA[prefix] == linesB[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(linesA)-prefix && suffix < len(linesB)-prefix && linesA[len(linesA)-1-suffix] == linesB[len(linesB)-1-suffix] {
+		suffix++
+	}
+
+	var lines []Line
+	for _, line := range linesA[:prefix] {
+		lines = append(lines, Line{Op: Equal, Text: line})
+	}
+
+	middleA, middleB := linesA[prefix:len(linesA)-suffix], linesB[prefix:len(linesB)-suffix]
+	middle, ok := diff(middleA, middleB, MaxEdits)
+	if !ok {
+		middle = replace(middleA, middleB)
+	}
+	lines = append(lines, middle...)
+
+	for _, line := range linesA[len(linesA)-suffix:] {
+		lines = append(lines, Line{Op: Equal, Text: line})
+	}
+	return lines
+}
+
+func splitLines(str string) []string {
+	if str == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(str, "\r\n", "\n"), "\n")
+}
+
+// diff implements Myers' O(ND) algorithm. Every step keeps a copy of the
+// explored part of the diagonal array for backtracking, which takes O(D²)
+// memory for D edits, so the search gives up after maxEdits edits.
+func diff(a, b []string, maxEdits int) ([]Line, bool) {
+	n, m := len(a), len(b)
+	max := n + m
+	offset := max + 1
+	v := make([]int, 2*max+3)
+	var trace [][]int
+
+	for d := 0; d <= max && d <= maxEdits; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrack(trace, a, b), true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func replace(a, b []string) []Line {
+	lines := make([]Line, 0, len(a)+len(b))
+	for _, line := range a {
+		lines = append(lines, Line{Op: Delete, Text: line})
+	}
+	for _, line := range b {
+		lines = append(lines, Line{Op: Insert, Text: line})
+	}
+	return lines
+}
+
+func backtrack(trace [][]int, a, b []string) []Line {
+	x, y := len(a), len(b)
+	var lines []Line
+
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		at := func(k int) int {
+			return v[k+d]
+		}
+
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := 0
+		if d > 0 {
+			prevX = at(prevK)
+		}
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			lines = append(lines, Line{Op: Equal, Text: a[x]})
+		}
+		if d > 0 {
+			if x == prevX {
+				lines = append(lines, Line{Op: Insert, Text: b[prevY]})
+			} else {
+				lines = append(lines, Line{Op: Delete, Text: a[prevX]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	for i, j := 0, len(lines)-1; i < j; i, j = i+1, j-1 {
+		lines[i], lines[j] = lines[j], lines[i]
+	}
+	return lines
+}
@@ -0,0 +1,119 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected []Line
+	}{
+		{
+			name:     "identical",
+			a:        "one\ntwo",
+			b:        "one\ntwo",
+			expected: []Line{{Op: Equal, Text: "one"}, {Op: Equal, Text: "two"}},
+		},
+		{
+			name:     "both empty",
+			a:        "",
+			b:        "",
+			expected: nil,
+		},
+		{
+			name:     "from empty",
+			a:        "",
+			b:        "one",
+			expected: []Line{{Op: Insert, Text: "one"}},
+		},
+		{
+			name:     "to empty",
+			a:        "one",
+			b:        "",
+			expected: []Line{{Op: Delete, Text: "one"}},
+		},
+		{
+			name: "changed line",
+			a:    "one\ntwo\nthree",
+			b:    "one\n2\nthree",
+			expected: []Line{
+				{Op: Equal, Text: "one"},
+				{Op: Delete, Text: "two"},
+				{Op: Insert, Text: "2"},
+				{Op: Equal, Text: "three"},
+			},
+		},
+		{
+			name: "crlf is normalized",
+			a:    "one\r\ntwo",
+			b:    "one\ntwo\nthree",
+			expected: []Line{
+				{Op: Equal, Text: "one"},
+				{Op: Equal, Text: "two"},
+				{Op: Insert, Text: "three"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Lines(test.a, test.b))
+		})
+	}
+}
+
+func TestLinesReconstructsBothSides(t *testing.T) {
+	a := "a\nb\nc\na\nb\nb\na"
+	b := "c\nb\na\nb\na\nc"
+
+	left, right := sides(Lines(a, b))
+	assert.Equal(t, splitLines(a), left)
+	assert.Equal(t, splitLines(b), right)
+}
+
+func TestLinesLargeInputs(t *testing.T) {
+	const size = 20000
+
+	unrelatedA := make([]string, size)
+	unrelatedB := make([]string, size)
+	for i := range size {
+		unrelatedA[i] = fmt.Sprintf("a%d", i)
+		unrelatedB[i] = fmt.Sprintf("b%d", i)
+	}
+	a, b := strings.Join(unrelatedA, "\n"), strings.Join(unrelatedB, "\n")
+
+	lines := Lines(a, b)
+	assert.Len(t, lines, 2*size)
+	assert.Equal(t, Delete, lines[0].Op)
+	assert.Equal(t, Insert, lines[size].Op)
+	left, right := sides(lines)
+	assert.Equal(t, unrelatedA, left)
+	assert.Equal(t, unrelatedB, right)
+
+	edited := append([]string(nil), unrelatedA...)
+	edited[size/2] = "changed"
+	lines = Lines(a, strings.Join(edited, "\n"))
+	assert.Len(t, lines, size+1)
+	assert.Equal(t, Line{Op: Delete, Text: unrelatedA[size/2]}, lines[size/2])
+	assert.Equal(t, Line{Op: Insert, Text: "changed"}, lines[size/2+1])
+}
+
+func sides(lines []Line) ([]string, []string) {
+	var left, right []string
+	for _, line := range lines {
+		if line.Op != Insert {
+			left = append(left, line.Text)
+		}
+		if line.Op != Delete {
+			right = append(right, line.Text)
+		}
+	}
+	return left, right
+}
@@ -0,0 +1,219 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"time"
+)
+
+type Feed struct {
+	Title       string
+	Description string
+	Link        string
+	FeedURL     string
+	Updated     time.Time
+	Items       []Item
+}
+
+type Item struct {
+	ID          string
+	Title       string
+	Link        string
+	AuthorURL   string
+	ContentHTML string
+	Tags        []string
+	Published   time.Time
+	Updated     time.Time
+}
+
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	AtomNS  string     `xml:"xmlns:atom,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	AtomLink      atomLink  `xml:"atom:link"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Description string   `xml:"description"`
+	Categories  []string `xml:"category"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Author  atomAuthor  `xml:"author"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+	URI  string `xml:"uri,omitempty"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Link       atomLink       `xml:"link"`
+	Author     *atomAuthor    `xml:"author,omitempty"`
+	Categories []atomCategory `xml:"category"`
+	Content    atomContent    `xml:"content"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type jsonFeed struct {
+	Version     string     `json:"version"`
+	Title       string     `json:"title"`
+	HomePageURL string     `json:"home_page_url"`
+	FeedURL     string     `json:"feed_url"`
+	Description string     `json:"description,omitempty"`
+	Items       []jsonItem `json:"items"`
+}
+
+type jsonItem struct {
+	ID            string       `json:"id"`
+	URL           string       `json:"url"`
+	Title         string       `json:"title"`
+	ContentHTML   string       `json:"content_html"`
+	DatePublished string       `json:"date_published"`
+	DateModified  string       `json:"date_modified"`
+	Authors       []jsonAuthor `json:"authors,omitempty"`
+	Tags          []string     `json:"tags,omitempty"`
+}
+
+type jsonAuthor struct {
+	URL string `json:"url"`
+}
+
+// RSS renders the feed as RSS 2.0.
+func RSS(f *Feed) ([]byte, error) {
+	channel := rssChannel{
+		Title:       f.Title,
+		Link:        f.Link,
+		Description: f.Description,
+		AtomLink:    atomLink{Href: f.FeedURL, Rel: "self", Type: "application/rss+xml"},
+		Items:       make([]rssItem, 0, len(f.Items)),
+	}
+	if !f.Updated.IsZero() {
+		channel.LastBuildDate = f.Updated.UTC().Format(time.RFC1123Z)
+	}
+	for _, item := range f.Items {
+		channel.Items = append(channel.Items, rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			GUID:        rssGUID{Value: item.ID},
+			PubDate:     item.Published.UTC().Format(time.RFC1123Z),
+			Description: item.ContentHTML,
+			Categories:  item.Tags,
+		})
+	}
+
+	return marshalXML(rss{Version: "2.0", AtomNS: "http://www.w3.org/2005/Atom", Channel: channel})
+}
+
+// Atom renders the feed as Atom 1.0.
+func Atom(f *Feed) ([]byte, error) {
+	feed := atomFeed{
+		ID:      f.FeedURL,
+		Title:   f.Title,
+		Updated: f.Updated.UTC().Format(time.RFC3339),
+		Author:  atomAuthor{Name: f.Title, URI: f.Link},
+		Links: []atomLink{
+			{Href: f.Link, Rel: "alternate"},
+			{Href: f.FeedURL, Rel: "self", Type: "application/atom+xml"},
+		},
+		Entries: make([]atomEntry, 0, len(f.Items)),
+	}
+	for _, item := range f.Items {
+		entry := atomEntry{
+			ID:        item.ID,
+			Title:     item.Title,
+			Published: item.Published.UTC().Format(time.RFC3339),
+			Updated:   item.Updated.UTC().Format(time.RFC3339),
+			Link:      atomLink{Href: item.Link, Rel: "alternate"},
+			Content:   atomContent{Type: "html", Value: item.ContentHTML},
+		}
+		if item.AuthorURL != "" {
+			entry.Author = &atomAuthor{Name: f.Title, URI: item.AuthorURL}
+		}
+		for _, tag := range item.Tags {
+			entry.Categories = append(entry.Categories, atomCategory{Term: tag})
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	return marshalXML(feed)
+}
+
+// JSON renders the feed as JSON Feed 1.1.
+func JSON(f *Feed) ([]byte, error) {
+	feed := jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       f.Title,
+		HomePageURL: f.Link,
+		FeedURL:     f.FeedURL,
+		Description: f.Description,
+		Items:       make([]jsonItem, 0, len(f.Items)),
+	}
+	for _, item := range f.Items {
+		jsonItem := jsonItem{
+			ID:            item.ID,
+			URL:           item.Link,
+			Title:         item.Title,
+			ContentHTML:   item.ContentHTML,
+			DatePublished: item.Published.UTC().Format(time.RFC3339),
+			DateModified:  item.Updated.UTC().Format(time.RFC3339),
+			Tags:          item.Tags,
+		}
+		if item.AuthorURL != "" {
+			jsonItem.Authors = []jsonAuthor{{URL: item.AuthorURL}}
+		}
+		feed.Items = append(feed.Items, jsonItem)
+	}
+
+	return json.Marshal(feed)
+}
+
+func marshalXML(v any) ([]byte, error) {
+	data, err := xml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
@@ -0,0 +1,92 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testFeed() *Feed {
+	published := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	return &Feed{
+		Title:       "Blog",
+		Description: "Posts",
+		Link:        "https://blog.example",
+		FeedURL:     "https://blog.example/feed.xml",
+		Updated:     published.Add(time.Hour),
+		Items: []Item{{
+			ID:          "urn:uuid:1",
+			Title:       "Hello <World>",
+			Link:        "https://blog.example/posts/hello-world",
+			AuthorURL:   "https://blog.example/authors/1",
+			ContentHTML: "<p>Hello &amp; bye</p>",
+			Tags:        []string{"go"},
+			Published:   published,
+			Updated:     published.Add(time.Hour),
+		}},
+	}
+}
+
+func TestRSS(t *testing.T) {
+	data, err := RSS(testFeed())
+	assert.NoError(t, err)
+
+	var parsed rss
+	assert.NoError(t, xml.Unmarshal(data, &parsed))
+	assert.Equal(t, "2.0", parsed.Version)
+	assert.Equal(t, "Fri, 02 Jan 2026 04:04:05 +0000", parsed.Channel.LastBuildDate)
+	if !assert.Len(t, parsed.Channel.Items, 1) {
+		return
+	}
+	item := parsed.Channel.Items[0]
+	assert.Equal(t, "Hello <World>", item.Title)
+	assert.Equal(t, "<p>Hello &amp; bye</p>", item.Description)
+	assert.Equal(t, "urn:uuid:1", item.GUID.Value)
+	assert.Equal(t, "Fri, 02 Jan 2026 03:04:05 +0000", item.PubDate)
+	assert.Equal(t, []string{"go"}, item.Categories)
+	assert.Contains(t, string(data), `<atom:link href="https://blog.example/feed.xml" rel="self"`)
+}
+
+func TestAtom(t *testing.T) {
+	data, err := Atom(testFeed())
+	assert.NoError(t, err)
+
+	var parsed atomFeed
+	assert.NoError(t, xml.Unmarshal(data, &parsed))
+	assert.Equal(t, "https://blog.example/feed.xml", parsed.ID)
+	assert.Equal(t, "2026-01-02T04:04:05Z", parsed.Updated)
+	if !assert.Len(t, parsed.Entries, 1) {
+		return
+	}
+	entry := parsed.Entries[0]
+	assert.Equal(t, "html", entry.Content.Type)
+	assert.Equal(t, "<p>Hello &amp; bye</p>", entry.Content.Value)
+	assert.Equal(t, "2026-01-02T03:04:05Z", entry.Published)
+	assert.Equal(t, "https://blog.example/authors/1", entry.Author.URI)
+}
+
+func TestJSON(t *testing.T) {
+	data, err := JSON(testFeed())
+	assert.NoError(t, err)
+
+	var parsed jsonFeed
+	assert.NoError(t, json.Unmarshal(data, &parsed))
+	assert.Equal(t, "https://jsonfeed.org/version/1.1", parsed.Version)
+	if !assert.Len(t, parsed.Items, 1) {
+		return
+	}
+	assert.Equal(t, "<p>Hello &amp; bye</p>", parsed.Items[0].ContentHTML)
+	assert.Equal(t, []string{"go"}, parsed.Items[0].Tags)
+}
+
+func TestEmptyFeed(t *testing.T) {
+	feed := testFeed()
+	feed.Items = nil
+
+	data, err := JSON(feed)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"items":[]`)
+}
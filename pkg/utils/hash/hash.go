@@ -1,6 +1,12 @@
 package hash
 
-import "golang.org/x/crypto/bcrypt"
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"golang.org/x/crypto/bcrypt"
+)
 
 func HashString(str string) (string, error) {
 	hashedStr, err := bcrypt.GenerateFromPassword([]byte(str), bcrypt.DefaultCost)
@@ -14,3 +20,19 @@ func CompareHashString(str string, hashedStr string) (bool, error) {
 	err := bcrypt.CompareHashAndPassword([]byte(hashedStr), []byte(str))
 	return err == nil, err
 }
+
+// SHA256String hashes high-entropy secrets such as tokens, which unlike
+// passwords have to be looked up by their hash.
+func SHA256String(str string) string {
+	sum := sha256.Sum256([]byte(str))
+	return hex.EncodeToString(sum[:])
+}
+
+// NewToken returns a random URL-safe secret for single-use links.
+func NewToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
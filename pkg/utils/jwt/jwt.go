@@ -5,23 +5,33 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
 )
 
-func NewAccessToken(id string, secret string) string {
+const RefreshTokenTTL = time.Hour * 24 * 7
+
+func NewAccessToken(id, sessionId string, secret string) string {
 	token := jwt.New(jwt.SigningMethodHS512)
 	token.Claims = jwt.MapClaims{
 		"sub": id,
+		"sid": sessionId,
+		"iat": time.Now().Unix(),
 		"exp": time.Now().Add(time.Hour * 2).Unix(),
 	}
 	tokenString, _ := token.SignedString([]byte(secret))
 	return tokenString
 }
 
-func NewRefreshToken(email string, secret string) (string, error) {
+// NewRefreshToken issues a refresh token of the session. Every token gets a
+// unique jti, so tokens rotated within the same second still differ.
+func NewRefreshToken(email, sessionId string, secret string) (string, error) {
 	token := jwt.New(jwt.SigningMethodHS512)
 	token.Claims = jwt.MapClaims{
 		"sub": email,
-		"exp": time.Now().Add(time.Hour * 24 * 7).Unix(),
+		"sid": sessionId,
+		"jti": uuid.NewString(),
+		"iat": time.Now().Unix(),
+		"exp": time.Now().Add(RefreshTokenTTL).Unix(),
 	}
 	return token.SignedString([]byte(secret))
 }
@@ -60,3 +70,24 @@ func ValidateToken(tokenString string, secret string) (*jwt.MapClaims, error) {
 
 	return &claims, nil
 }
+
+// IssuedAt returns the iat claim of the token, or the zero time for tokens
+// issued without it.
+func IssuedAt(claims *jwt.MapClaims) time.Time {
+	iat, ok := (*claims)["iat"].(float64)
+	if !ok {
+		return time.Time{}
+	}
+	return time.Unix(int64(iat), 0)
+}
+
+// SessionId returns the sid claim of the token, or an empty string for
+// tokens issued without a valid one.
+func SessionId(claims *jwt.MapClaims) string {
+	sid, _ := (*claims)["sid"].(string)
+	id, err := uuid.Parse(sid)
+	if err != nil {
+		return ""
+	}
+	return id.String()
+}
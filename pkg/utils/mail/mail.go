@@ -1,43 +1,59 @@
 package mail
 
 import (
-	"net"
+	"net/mail"
 	"strings"
 	"unicode/utf8"
 )
 
+// IsValidEmail reports whether email is a bare RFC 5322 addr-spec within the
+// SMTP length limits. It does no network lookups.
 func IsValidEmail(email string) bool {
 	totalLength := utf8.RuneCountInString(email)
 	if totalLength == 0 || totalLength > 254 {
 		return false
 	}
 
-	parts := strings.Split(email, "@")
-	if len(parts) != 2 {
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Name != "" || address.Address != email {
 		return false
 	}
-	localPart := parts[0]
-	domainPart := parts[1]
 
-	localLength := utf8.RuneCountInString(localPart)
+	at := strings.LastIndex(email, "@")
+	localPart := email[:at]
+	domainPart := email[at+1:]
 
+	localLength := utf8.RuneCountInString(localPart)
 	if localLength == 0 || localLength > 64 {
 		return false
 	}
 
-	domainLength := utf8.RuneCountInString(domainPart)
+	return isValidDomain(domainPart)
+}
+
+// Domain returns the lowercased domain part of the email.
+func Domain(email string) string {
+	return strings.ToLower(email[strings.LastIndex(email, "@")+1:])
+}
 
+func isValidDomain(domain string) bool {
+	domainLength := utf8.RuneCountInString(domain)
 	if domainLength == 0 || domainLength > 253 {
 		return false
 	}
 
-	return hasMXRecord(domainPart)
-}
-
-func hasMXRecord(domain string) bool {
-	records, err := net.LookupMX(domain)
-	if err != nil || len(records) == 0 {
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
 		return false
 	}
+	for _, label := range labels {
+		if label == "" || utf8.RuneCountInString(label) > 63 {
+			return false
+		}
+		if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return false
+		}
+	}
+
 	return true
 }
@@ -0,0 +1,43 @@
+package mail
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidEmail(t *testing.T) {
+	tests := []struct {
+		name     string
+		email    string
+		expected bool
+	}{
+		{name: "simple", email: "test@yandex.ru", expected: true},
+		{name: "plus and dots", email: "first.last+blog@mail.example.com", expected: true},
+		{name: "empty", email: "", expected: false},
+		{name: "no at", email: "test.yandex.ru", expected: false},
+		{name: "two ats", email: "test@@yandex.ru", expected: false},
+		{name: "no local part", email: "@yandex.ru", expected: false},
+		{name: "no domain", email: "test@", expected: false},
+		{name: "single label domain", email: "test@localhost", expected: false},
+		{name: "empty label", email: "test@yandex..ru", expected: false},
+		{name: "hyphen at label edge", email: "test@-yandex.ru", expected: false},
+		{name: "display name", email: "Test <test@yandex.ru>", expected: false},
+		{name: "spaces", email: " test@yandex.ru", expected: false},
+		{name: "long local part", email: strings.Repeat("a", 65) + "@yandex.ru", expected: false},
+		{name: "long label", email: "test@" + strings.Repeat("a", 64) + ".ru", expected: false},
+		{name: "long email", email: "test@" + strings.Repeat("a.", 125) + "ru", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, IsValidEmail(test.email))
+		})
+	}
+}
+
+func TestDomain(t *testing.T) {
+	assert.Equal(t, "yandex.ru", Domain("Test@Yandex.RU"))
+	assert.Equal(t, "mail.example.com", Domain("a.b@mail.example.com"))
+}
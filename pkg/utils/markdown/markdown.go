@@ -0,0 +1,24 @@
+package markdown
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+var renderer = goldmark.New(
+	goldmark.WithExtensions(extension.Strikethrough),
+	goldmark.WithRendererOptions(html.WithUnsafe()),
+)
+
+// Render converts CommonMark with strikethrough to HTML. Raw HTML is passed
+// through as is, so the result must be sanitized before display.
+func Render(src string) string {
+	var b bytes.Buffer
+	if err := renderer.Convert([]byte(src), &b); err != nil {
+		return ""
+	}
+	return b.String()
+}
@@ -0,0 +1,86 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		expected string
+	}{
+		{
+			name:     "empty",
+			src:      "",
+			expected: "",
+		},
+		{
+			name:     "paragraphs",
+			src:      "first\nline\n\nsecond",
+			expected: "<p>first\nline</p>\n<p>second</p>\n",
+		},
+		{
+			name:     "heading",
+			src:      "## Title ##",
+			expected: "<h2>Title</h2>\n",
+		},
+		{
+			name:     "emphasis",
+			src:      "**bold** *em* __strong__ _em_ ~~del~~ snake_case_word",
+			expected: "<p><strong>bold</strong> <em>em</em> <strong>strong</strong> <em>em</em> <del>del</del> snake_case_word</p>\n",
+		},
+		{
+			name:     "code span",
+			src:      "use `<b>` and ``a`b``",
+			expected: "<p>use <code>&lt;b&gt;</code> and <code>a`b</code></p>\n",
+		},
+		{
+			name:     "fenced code",
+			src:      "```go\nif a < b {}\n```",
+			expected: "<pre><code class=\"language-go\">if a &lt; b {}\n</code></pre>\n",
+		},
+		{
+			name:     "indented code",
+			src:      "    one\n    two",
+			expected: "<pre><code>one\ntwo\n</code></pre>\n",
+		},
+		{
+			name:     "link and image",
+			src:      "[site](https://example.com \"Example\") ![logo](/logo.png)",
+			expected: "<p><a href=\"https://example.com\" title=\"Example\">site</a> <img src=\"/logo.png\" alt=\"logo\"></p>\n",
+		},
+		{
+			name:     "autolink",
+			src:      "<https://example.com> <user@example.com>",
+			expected: "<p><a href=\"https://example.com\">https://example.com</a> <a href=\"mailto:user@example.com\">user@example.com</a></p>\n",
+		},
+		{
+			name:     "lists",
+			src:      "- a\n- b\n\n3. c\n4. d",
+			expected: "<ul>\n<li>a</li>\n<li>b</li>\n</ul>\n<ol start=\"3\">\n<li>c</li>\n<li>d</li>\n</ol>\n",
+		},
+		{
+			name:     "blockquote",
+			src:      "> quoted\n> *text*",
+			expected: "<blockquote>\n<p>quoted\n<em>text</em></p>\n</blockquote>\n",
+		},
+		{
+			name:     "hard break and rule",
+			src:      "one  \ntwo\n\n---",
+			expected: "<p>one<br>\ntwo</p>\n<hr>\n",
+		},
+		{
+			name:     "escapes",
+			src:      "\\*not em\\* a & b < c &amp;",
+			expected: "<p>*not em* a &amp; b &lt; c &amp;</p>\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Render(test.src))
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package sanitize
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var policy = newPolicy()
+
+// newPolicy allows the markup produced by the Markdown renderer and nothing
+// else: no scripts or styles, no event handlers and only http, https and
+// mailto links.
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+
+	p.AllowElements("abbr", "b", "blockquote", "br", "code", "del", "em", "h1", "h2", "h3", "h4", "h5", "h6", "hr", "i", "li", "ol", "p", "pre", "s", "strong", "sub", "sup", "table", "tbody", "td", "th", "thead", "tr", "u", "ul")
+	p.AllowAttrs("href", "title").OnElements("a")
+	p.AllowAttrs("title").OnElements("abbr")
+	p.AllowAttrs("src", "alt", "title").OnElements("img")
+	p.AllowAttrs("start").Matching(bluemonday.Integer).OnElements("ol")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+#-]+$`)).OnElements("code")
+
+	p.AllowURLSchemes("http", "https", "mailto")
+	p.AllowRelativeURLs(true)
+	p.RequireParseableURLs(true)
+	p.RequireNoFollowOnLinks(true)
+
+	return p
+}
+
+// HTML keeps only whitelisted tags and attributes, removes scripts and other
+// active content together with their text, drops links with unsafe schemes and
+// closes every tag it keeps.
+func HTML(src string) string {
+	return policy.Sanitize(balance(src))
+}
+
+// balance reparses the fragment the way a browser would inside <body>, which
+// closes open tags and drops stray closing ones.
+func balance(src string) string {
+	nodes, err := html.ParseFragment(strings.NewReader(src), &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body})
+	if err != nil {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, node := range nodes {
+		if err = html.Render(&b, node); err != nil {
+			return ""
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,66 @@
+package sanitize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		expected string
+	}{
+		{
+			name:     "allowed markup",
+			src:      "<p>Hello <strong>world</strong></p>",
+			expected: "<p>Hello <strong>world</strong></p>",
+		},
+		{
+			name:     "script",
+			src:      "<p>a</p><script>alert(1)</script><style>p{}</style>",
+			expected: "<p>a</p>",
+		},
+		{
+			name:     "event handlers",
+			src:      "<img src=\"/a.png\" onerror=\"alert(1)\"><p onclick=\"x\">b</p>",
+			expected: "<img src=\"/a.png\"/><p>b</p>",
+		},
+		{
+			name:     "unsafe urls",
+			src:      "<a href=\"javascript:alert(1)\">a</a><a href=\"java\tscript:alert(1)\">b</a><img src=\"data:image/png;base64,AA\">",
+			expected: "ab",
+		},
+		{
+			name:     "safe urls",
+			src:      "<a href=\"https://example.com/?a=1&amp;b=2\">a</a><a href=\"/posts/go\">b</a><a href=\"mailto:a@b.c\">c</a>",
+			expected: "<a href=\"https://example.com/?a=1&amp;b=2\" rel=\"nofollow\">a</a><a href=\"/posts/go\" rel=\"nofollow\">b</a><a href=\"mailto:a@b.c\" rel=\"nofollow\">c</a>",
+		},
+		{
+			name:     "unknown tags keep text",
+			src:      "<div><span>text</span></div><iframe src=\"https://evil\">x</iframe>",
+			expected: "text",
+		},
+		{
+			name:     "unbalanced tags",
+			src:      "<p><em>open</p></strong>",
+			expected: "<p><em>open</em></p>",
+		},
+		{
+			name:     "code class",
+			src:      "<code class=\"language-go\">a</code><code class=\"evil\">b</code>",
+			expected: "<code class=\"language-go\">a</code><code>b</code>",
+		},
+		{
+			name:     "text escaped",
+			src:      "a &lt;script&gt; b",
+			expected: "a &lt;script&gt; b",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, HTML(test.src))
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package sitemap
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// MaxURLs is the number of URLs a single sitemap file may list.
+const MaxURLs = 50000
+
+const namespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+type URL struct {
+	Loc     string
+	LastMod time.Time
+}
+
+type urlSet struct {
+	XMLName xml.Name   `xml:"urlset"`
+	XMLNS   string     `xml:"xmlns,attr"`
+	URLs    []location `xml:"url"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name   `xml:"sitemapindex"`
+	XMLNS    string     `xml:"xmlns,attr"`
+	Sitemaps []location `xml:"sitemap"`
+}
+
+type location struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// URLSet renders a sitemap listing the given URLs.
+func URLSet(urls []URL) ([]byte, error) {
+	return marshal(urlSet{XMLNS: namespace, URLs: locations(urls)})
+}
+
+// Index renders a sitemap index listing the given sitemaps.
+func Index(sitemaps []URL) ([]byte, error) {
+	return marshal(sitemapIndex{XMLNS: namespace, Sitemaps: locations(sitemaps)})
+}
+
+// Pages returns the number of sitemap files needed for count URLs.
+func Pages(count int) int {
+	if count <= 0 {
+		return 1
+	}
+	return (count + MaxURLs - 1) / MaxURLs
+}
+
+func locations(urls []URL) []location {
+	result := make([]location, 0, len(urls))
+	for _, url := range urls {
+		loc := location{Loc: url.Loc}
+		if !url.LastMod.IsZero() {
+			loc.LastMod = url.LastMod.UTC().Format(time.RFC3339)
+		}
+		result = append(result, loc)
+	}
+	return result
+}
+
+func marshal(v any) ([]byte, error) {
+	data, err := xml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
@@ -0,0 +1,48 @@
+package sitemap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURLSet(t *testing.T) {
+	lastMod := time.Date(2026, 1, 2, 3, 4, 5, 0, time.FixedZone("MSK", 3*60*60))
+
+	data, err := URLSet([]URL{
+		{Loc: "https://blog.example/posts/a&b", LastMod: lastMod},
+		{Loc: "https://blog.example/tags/go/posts"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`+
+		`<url><loc>https://blog.example/posts/a&amp;b</loc><lastmod>2026-01-02T00:04:05Z</lastmod></url>`+
+		`<url><loc>https://blog.example/tags/go/posts</loc></url>`+
+		`</urlset>`, string(data))
+}
+
+func TestIndex(t *testing.T) {
+	data, err := Index([]URL{{Loc: "https://blog.example/sitemaps/sitemap-1.xml"}})
+
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9"><sitemap><loc>https://blog.example/sitemaps/sitemap-1.xml</loc></sitemap></sitemapindex>`)
+}
+
+func TestPages(t *testing.T) {
+	tests := []struct {
+		count    int
+		expected int
+	}{
+		{count: 0, expected: 1},
+		{count: 1, expected: 1},
+		{count: MaxURLs, expected: 1},
+		{count: MaxURLs + 1, expected: 2},
+		{count: 3 * MaxURLs, expected: 3},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, Pages(test.count), "count=%d", test.count)
+	}
+}
@@ -0,0 +1,44 @@
+package slug
+
+import (
+	"strings"
+	"unicode"
+)
+
+const MaxLength = 64
+
+var cyrillic = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+	'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "h", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "sch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+	'я': "ya",
+}
+
+func Make(str string) string {
+	var builder strings.Builder
+	dash := false
+
+	for _, r := range strings.ToLower(str) {
+		if latin, ok := cyrillic[r]; ok {
+			builder.WriteString(latin)
+			dash = false
+			continue
+		}
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			builder.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash && builder.Len() > 0 {
+			builder.WriteByte('-')
+			dash = true
+		}
+	}
+
+	result := builder.String()
+	if len(result) > MaxLength {
+		result = result[:MaxLength]
+	}
+	return strings.Trim(result, "-")
+}
@@ -0,0 +1,29 @@
+package slug
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "latin", input: "Hello World", expected: "hello-world"},
+		{name: "cyrillic", input: "Привет, мир!", expected: "privet-mir"},
+		{name: "punctuation runs", input: "  Go -- 1.25 / News  ", expected: "go-1-25-news"},
+		{name: "mixed case is normalized", input: "GoLang", expected: "golang"},
+		{name: "only punctuation", input: "!!!", expected: ""},
+		{name: "too long", input: strings.Repeat("a", MaxLength+10), expected: strings.Repeat("a", MaxLength)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Make(test.input))
+		})
+	}
+}